@@ -0,0 +1,130 @@
+package geojson
+
+import (
+	"math"
+	"slices"
+)
+
+// Translate moves every position of the geometry, in place, by dx along the longitude axis and dy along
+// the latitude axis, keeping altitudes.
+//
+// Translate, Scale, and Rotate are affine transforms treating longitude and latitude as planar x and y
+// values, as suited to diagrams and local or non-geographic coordinate spaces. The transformed positions
+// are not validated, so they may leave the longitude and latitude ranges, which Feature.ValidateRFC7946
+// reports. Empty objects are left unchanged.
+func (g *GeometryObject) Translate(dx, dy float64) {
+	transformGeometry(g.geometry, func(c Coordinates) {
+		c[idxCoordsLng] += dx
+		c[idxCoordsLat] += dy
+	})
+}
+
+// Scale multiplies the distances of every position of the geometry from origin, in place, by sx along
+// the longitude axis and sy along the latitude axis, keeping altitudes. When the factors have opposite
+// signs the geometry is mirrored, and the rings of polygons are reversed so that their orientation is kept.
+// See Translate for the coordinate space assumed.
+func (g *GeometryObject) Scale(sx, sy float64, origin Coordinates) {
+	transformGeometry(g.geometry, func(c Coordinates) {
+		c[idxCoordsLng] = origin[idxCoordsLng] + (c[idxCoordsLng]-origin[idxCoordsLng])*sx
+		c[idxCoordsLat] = origin[idxCoordsLat] + (c[idxCoordsLat]-origin[idxCoordsLat])*sy
+	})
+
+	if sx*sy < 0 {
+		reverseRings(g.geometry)
+	}
+}
+
+// Rotate rotates every position of the geometry by angleDeg degrees counterclockwise about origin, in place,
+// keeping altitudes. The angle is in degrees rather than radians, like the Rotate methods of the geometry types,
+// so both APIs accept the same values. Unlike Polygon.Rotate, which scales longitude differences to approximate
+// shapes on the globe, it rotates in the plane. See Translate for the coordinate space assumed.
+func (g *GeometryObject) Rotate(angleDeg float64, origin Coordinates) {
+	sin, cos := math.Sincos(degreesToRadians(angleDeg))
+	r := planarRotation{origin: origin, sin: sin, cos: cos, scale: 1}
+
+	transformGeometry(g.geometry, r.apply)
+}
+
+// transformGeometry replaces every position of the geometry with a copy modified by fn, recursing into
+// the children of a GeometryCollection, and clears the bounding boxes memoized by its polygons. Working on
+// copies transforms positions sharing their values, such as the first and closing positions of a ring, once.
+func transformGeometry(g Geometry, fn func(c Coordinates)) {
+	switch v := g.(type) {
+	case *Point:
+		v.coords = transformed(v.coords, fn)
+	case *LineString:
+		transformVertices(v.vertices, fn)
+	case *MultiPoint:
+		transformVertices(v.vertices, fn)
+	case *MultiLineString:
+		for _, s := range v.segments {
+			transformVertices(s, fn)
+		}
+	case *Polygon:
+		for _, ring := range v.rings {
+			transformVertices(Vertices(ring), fn)
+		}
+		v.InvalidateBBox()
+	case *MultiPolygon:
+		for _, rings := range v.rings {
+			for _, ring := range rings {
+				transformVertices(Vertices(ring), fn)
+			}
+		}
+		v.InvalidateBBox()
+	case *GeometryCollection:
+		for _, child := range v.geometries {
+			transformGeometry(child, fn)
+		}
+	}
+}
+
+// transformVertices replaces each of the vertices with a copy modified by fn.
+func transformVertices(v Vertices, fn func(c Coordinates)) {
+	for i, c := range v {
+		v[i] = transformed(c, fn)
+	}
+}
+
+// transformed returns a copy of the position modified by fn.
+func transformed(c Coordinates, fn func(c Coordinates)) Coordinates {
+	c = slices.Clone(c)
+	fn(c)
+	return c
+}
+
+// invalidateBBoxes clears the bounding boxes memoized by the polygons of the geometry,
+// recursing into the children of a GeometryCollection.
+func invalidateBBoxes(g Geometry) {
+	switch v := g.(type) {
+	case *Polygon:
+		v.InvalidateBBox()
+	case *MultiPolygon:
+		v.InvalidateBBox()
+	case *GeometryCollection:
+		for _, child := range v.geometries {
+			invalidateBBoxes(child)
+		}
+	}
+}
+
+// reverseRings reverses, in place, the order of the positions of every ring of the polygons of the geometry,
+// recursing into the children of a GeometryCollection.
+func reverseRings(g Geometry) {
+	switch v := g.(type) {
+	case *Polygon:
+		for _, ring := range v.rings {
+			slices.Reverse(ring)
+		}
+	case *MultiPolygon:
+		for _, rings := range v.rings {
+			for _, ring := range rings {
+				slices.Reverse(ring)
+			}
+		}
+	case *GeometryCollection:
+		for _, child := range v.geometries {
+			reverseRings(child)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package geojson
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeometryObject_Translate(t *testing.T) {
+	polygon := MustPolygon(LinearRings{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}})
+	require.Equal(t, BoundingBox{0, 0, 1, 1}, polygon.CachedBoundingBox())
+
+	g := NewGeometryCollectionFromSlice([]Geometry{MustPoint([]float64{1, 2, 30}), polygon}).AsGeometryObject()
+	g.Translate(10, -5)
+
+	assertVerticesInDelta(t, Vertices{{11, -3, 30}, {10, -5}, {11, -5}, {11, -4}, {10, -5}}, g.geometry.Vertices())
+	assert.Equal(t, BoundingBox{10, -5, 11, -4}, polygon.CachedBoundingBox(), "the cached bounding box is refreshed")
+
+	g.Translate(200, 0)
+	f := Feature{Geometry: g.geometry}
+	assert.ErrorIs(t, errors.Join(f.ValidateRFC7946()...), ErrLongitudeRange)
+
+	g = FromGeometry(&Polygon{rings: LinearRings{sharedClosingRing()}})
+	g.Translate(10, 0)
+	assertVerticesInDelta(t, Vertices{{9, -1}, {11, -1}, {11, 1}, {9, 1}, {9, -1}}, g.geometry.Vertices())
+
+	empty := GeometryObject{}
+	empty.Translate(1, 1)
+	assert.True(t, empty.IsEmpty())
+}
+
+func TestGeometryObject_Scale(t *testing.T) {
+	tests := []struct {
+		name     string
+		geometry Geometry
+		sx, sy   float64
+		origin   Coordinates
+		expected Vertices
+	}{
+		{
+			name:     "about the origin",
+			geometry: MustLineString(Vertices{{1, 1, 5}, {2, 3}}),
+			sx:       2,
+			sy:       3,
+			origin:   Coordinates{0, 0},
+			expected: Vertices{{2, 3, 5}, {4, 9}},
+		},
+		{
+			name:     "about a position",
+			geometry: NewMultiPointFromVertices(Vertices{{11, 21}, {9, 20}}),
+			sx:       0.5,
+			sy:       0.5,
+			origin:   Coordinates{10, 20},
+			expected: Vertices{{10.5, 20.5}, {9.5, 20}},
+		},
+		{
+			name:     "mirrored polygon keeps its orientation",
+			geometry: MustPolygon(LinearRings{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}),
+			sx:       -1,
+			sy:       1,
+			origin:   Coordinates{0, 0},
+			expected: Vertices{{0, 0}, {0, 2}, {-2, 2}, {-2, 0}, {0, 0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := FromGeometry(tt.geometry)
+			g.Scale(tt.sx, tt.sy, tt.origin)
+			assertVerticesInDelta(t, tt.expected, g.geometry.Vertices())
+
+			if p, ok := tt.geometry.(*Polygon); ok {
+				assert.True(t, p.IsRightHandRule())
+			}
+		})
+	}
+}
+
+func TestGeometryObject_Rotate(t *testing.T) {
+	g := FromGeometry(MustMultiLineString(Segments{{{11, 60}, {10, 61, 7}}}))
+	g.Rotate(90, Coordinates{10, 60})
+
+	// Unlike Polygon.Rotate, longitude differences are not scaled by the latitude.
+	assertVerticesInDelta(t, Vertices{{10, 61}, {9, 60, 7}}, g.geometry.Vertices())
+
+	polygon := MustPolygon(LinearRings{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}})
+	g = FromGeometry(MustMultiPolygonFromRingSlice([]LinearRings{polygon.rings}))
+	g.Rotate(180, Coordinates{0, 0})
+	assertVerticesInDelta(t, Vertices{{0, 0}, {-1, 0}, {-1, -1}, {0, 0}}, g.geometry.Vertices())
+}
@@ -0,0 +1,215 @@
+package geojson
+
+import (
+	"math"
+	"slices"
+)
+
+// crossesAntimeridian reports whether the edge from a to b crosses the antimeridian, that is, whether the
+// shorter way between the two longitudes goes across ±180°.
+func crossesAntimeridian(a, b Coordinates) bool {
+	return math.Abs(b[idxCoordsLng]-a[idxCoordsLng]) > 180
+}
+
+// unwrapLongitude returns a copy of b whose longitude is shifted by a multiple of 360° to be within 180°
+// of the longitude of a, so that the edge from a to b does not wrap around.
+func unwrapLongitude(a, b Coordinates) Coordinates {
+	c := append(Coordinates(nil), b...)
+	for c[idxCoordsLng]-a[idxCoordsLng] > 180 {
+		c[idxCoordsLng] -= 360
+	}
+	for a[idxCoordsLng]-c[idxCoordsLng] > 180 {
+		c[idxCoordsLng] += 360
+	}
+	return c
+}
+
+// CrossesAntimeridian reports whether the LineString crosses the antimeridian, that is, whether any two
+// consecutive vertices have longitudes differing by more than 180°, so that the shorter way between them goes
+// across ±180°. It is a cheap check telling whether SplitAtAntimeridian would split the LineString.
+func (l *LineString) CrossesAntimeridian() bool {
+	return verticesCrossAntimeridian(l.vertices)
+}
+
+// CrossesAntimeridian reports whether the LinearRing crosses the antimeridian, as LineString.CrossesAntimeridian
+// does for its consecutive positions.
+func (lr *LinearRing) CrossesAntimeridian() bool {
+	return verticesCrossAntimeridian(Vertices(*lr))
+}
+
+// CrossesAntimeridian reports whether any ring of the Polygon crosses the antimeridian, as
+// LineString.CrossesAntimeridian does for its consecutive positions. It is a cheap check telling whether
+// SplitAtAntimeridian would split the Polygon, except for polygons enclosing a pole.
+func (p *Polygon) CrossesAntimeridian() bool {
+	return ringsCrossAntimeridian(p.rings)
+}
+
+// SplitAtAntimeridian splits the LineString where its edges cross the antimeridian, which are the edges
+// whose longitudes differ by more than 180°. Each crossing ends a part at ±180° and starts the next one at
+// the opposite side, at a latitude, and altitude if present, linearly interpolated along the edge.
+// The result always holds at least one line, a copy of the LineString when it does not cross.
+func (l *LineString) SplitAtAntimeridian() *MultiLineString {
+	if len(l.vertices) == 0 {
+		return &MultiLineString{}
+	}
+
+	var parts Segments
+	current := Vertices{append(Coordinates(nil), l.vertices[0]...)}
+	for i := 1; i < len(l.vertices); i++ {
+		a, b := l.vertices[i-1], l.vertices[i]
+		if crossesAntimeridian(a, b) {
+			edge := math.Copysign(180, a[idxCoordsLng])
+			unwrapped := unwrapLongitude(a, b)
+
+			crossing := interpolateLinear(a, unwrapped, (edge-a[idxCoordsLng])/(unwrapped[idxCoordsLng]-a[idxCoordsLng]))
+			crossing[idxCoordsLng] = edge
+			parts = appendPart(parts, removeConsecutiveDuplicates(append(current, crossing)))
+
+			next := append(Coordinates(nil), crossing...)
+			next[idxCoordsLng] = -edge
+			current = Vertices{next}
+		}
+		current = append(current, append(Coordinates(nil), b...))
+	}
+	parts = appendPart(parts, removeConsecutiveDuplicates(current))
+
+	return &MultiLineString{segments: parts}
+}
+
+// SplitAtAntimeridian splits the Polygon into the parts lying on each side of the antimeridian, when one of
+// its rings has edges whose longitudes differ by more than 180°. The rings are first made continuous by
+// shifting longitudes by 360°, then clipped on each side of ±180°, where new positions are inserted,
+// and shifted back. The result holds a copy of the Polygon when it does not cross the antimeridian.
+//
+// Polygons enclosing a pole, whose outer ring wraps all the way around the globe, are not supported:
+// for them the result holds a copy of the Polygon unchanged.
+func (p *Polygon) SplitAtAntimeridian() *MultiPolygon {
+	original := &MultiPolygon{rings: []LinearRings{mapLinearRings(p.rings, cloneVertices)}}
+	if len(p.rings) == 0 || !ringsCrossAntimeridian(p.rings) {
+		return original
+	}
+
+	// Make each ring continuous, keeping the inner rings within the longitudes of the outer ring.
+	rings := make(LinearRings, len(p.rings))
+	for i, ring := range p.rings {
+		unwrapped, ok := unwrapRing(ring)
+		if !ok {
+			return original
+		}
+		if i > 0 {
+			shiftRingNear(unwrapped, rings[0])
+		}
+		rings[i] = unwrapped
+	}
+
+	var slice []LinearRings
+	for _, shift := range []float64{-360, 0, 360} {
+		window := boxExtent{minLng: LongitudeMin - shift, minLat: LatitudeMin, maxLng: LongitudeMax - shift, maxLat: LatitudeMax}
+		clipped, ok := (&Polygon{rings: rings}).clipToExtent(window)
+		if !ok {
+			continue
+		}
+
+		// Positions on the antimeridian may be shared by the parts on both sides, so shift copies of them.
+		parts := mapLinearRings(clipped.rings, cloneVertices)
+		for _, ring := range parts {
+			for _, c := range ring {
+				c[idxCoordsLng] += shift
+			}
+		}
+		slice = append(slice, parts)
+	}
+
+	return &MultiPolygon{rings: slice}
+}
+
+// ringsCrossAntimeridian reports whether any edge of the rings crosses the antimeridian.
+func ringsCrossAntimeridian(rings LinearRings) bool {
+	for _, ring := range rings {
+		if verticesCrossAntimeridian(Vertices(ring)) {
+			return true
+		}
+	}
+	return false
+}
+
+// verticesCrossAntimeridian reports whether any edge between consecutive vertices crosses the antimeridian.
+func verticesCrossAntimeridian(v Vertices) bool {
+	for i := 1; i < len(v); i++ {
+		if crossesAntimeridian(v[i-1], v[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// unwrapRing returns a copy of the ring whose longitudes are shifted by multiples of 360° so that no edge
+// wraps around. It returns false if the ring does not close once unwrapped, which happens when it encloses a pole.
+func unwrapRing(ring LinearRing) (LinearRing, bool) {
+	out := make(LinearRing, len(ring))
+	out[0] = append(Coordinates(nil), ring[0]...)
+	for i := 1; i < len(ring); i++ {
+		out[i] = unwrapLongitude(out[i-1], ring[i])
+	}
+
+	return out, out[0].IsEqual(out[len(out)-1])
+}
+
+// shiftRingNear shifts the longitudes of the ring in place by a multiple of 360° so that its first position
+// lies within 180° of the first position of the reference ring.
+func shiftRingNear(ring, reference LinearRing) {
+	shift := unwrapLongitude(reference[0], ring[0])[idxCoordsLng] - ring[0][idxCoordsLng]
+	for _, c := range ring {
+		c[idxCoordsLng] += shift
+	}
+}
+
+// GeographicBoundingBox returns the smallest bounding box enclosing the geometry on the globe, allowing its
+// longitude extent to wrap around the antimeridian. The latitude and altitude extents are those of the regular
+// bounding box, while the longitude extent is the shortest arc holding every longitude, found as the complement
+// of the widest gap between them. When that arc crosses the antimeridian, the box is returned with its minimum
+// longitude greater than its maximum, as RFC 7946 prescribes: points at 179° and -179° give a box from 179°
+// to -179°, 2° wide, rather than one spanning 358°. When no shorter wrapping arc exists, the regular
+// longitude extent is kept.
+//
+// Boxes whose minimum longitude exceeds the maximum are not supported by BoundingBox methods such as
+// Intersects, Contains, and ToPolygon. It returns an empty bounding box for empty objects.
+func (g *GeometryObject) GeographicBoundingBox() BoundingBox {
+	var vertices Vertices
+	walkGeometry(g.geometry, func(c Coordinates) bool {
+		vertices = append(vertices, c)
+		return true
+	})
+
+	box := bbox(vertices)
+	if len(vertices) == 0 {
+		return box
+	}
+
+	longitudes := make([]float64, len(vertices))
+	for i, c := range vertices {
+		longitudes[i] = c[idxCoordsLng]
+	}
+	slices.Sort(longitudes)
+
+	// The gap across the antimeridian, between the largest longitude and the smallest one, is the one
+	// left out by the regular extent; a wider gap elsewhere gives a shorter arc that wraps around.
+	last := len(longitudes) - 1
+	widestGap := longitudes[0] + 360 - longitudes[last]
+	minLng, maxLng := longitudes[0], longitudes[last]
+	for i := 0; i < last; i++ {
+		if gap := longitudes[i+1] - longitudes[i]; gap > widestGap {
+			widestGap = gap
+			minLng, maxLng = longitudes[i+1], longitudes[i]
+		}
+	}
+
+	box[idxBBoxMinLng] = minLng
+	if box.Is3D() {
+		box[idxBBox3DMaxLng] = maxLng
+	} else {
+		box[idxBBox2DMaxLng] = maxLng
+	}
+
+	return box
+}
@@ -0,0 +1,197 @@
+package geojson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLineString_SplitAtAntimeridian(t *testing.T) {
+	tests := []struct {
+		name     string
+		vertices Vertices
+		expected Segments
+	}{
+		{
+			name:     "no crossing",
+			vertices: Vertices{{170, 0}, {175, 5}},
+			expected: Segments{{{170, 0}, {175, 5}}},
+		},
+		{
+			name:     "eastward crossing",
+			vertices: Vertices{{170, 0}, {-170, 10, 100}},
+			expected: Segments{{{170, 0}, {180, 5}}, {{-180, 5}, {-170, 10, 100}}},
+		},
+		{
+			name:     "westward crossings with altitude",
+			vertices: Vertices{{-175, 0, 0}, {175, 10, 100}, {-175, 20, 200}},
+			expected: Segments{
+				{{-175, 0, 0}, {-180, 5, 50}},
+				{{180, 5, 50}, {175, 10, 100}, {180, 15, 150}},
+				{{-180, 15, 150}, {-175, 20, 200}},
+			},
+		},
+		{
+			name:     "starting on the antimeridian",
+			vertices: Vertices{{180, 0}, {-170, 0}},
+			expected: Segments{{{-180, 0}, {-170, 0}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := MustLineString(tt.vertices)
+			m := l.SplitAtAntimeridian()
+			assert.Len(t, m.Segments(), len(tt.expected))
+			for i, segment := range m.Segments() {
+				for j, c := range segment {
+					assert.InDeltaSlice(t, tt.expected[i][j], c, 1e-9)
+				}
+			}
+			assert.Equal(t, tt.vertices, l.Vertices())
+		})
+	}
+}
+
+func TestCrossesAntimeridian(t *testing.T) {
+	tests := []struct {
+		name     string
+		vertices Vertices
+		expected bool
+	}{
+		{"empty", nil, false},
+		{"eastward crossing", Vertices{{170, 0}, {-170, 0}}, true},
+		{"westward crossing", Vertices{{0, 0}, {-175, 5}, {175, 5}}, true},
+		{"across the prime meridian", Vertices{{-90, 0}, {90, 0}}, false},
+		{"along the antimeridian", Vertices{{180, -10}, {180, 10}}, false},
+		{"touching it from both sides", Vertices{{179, 0}, {180, 0}, {-179, 0}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &LineString{vertices: tt.vertices}
+			assert.Equal(t, tt.expected, l.CrossesAntimeridian())
+		})
+	}
+
+	crossing := LinearRing{{170, -10}, {-170, -10}, {-170, 10}, {170, 10}, {170, -10}}
+	square := LinearRing{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}
+	assert.True(t, crossing.CrossesAntimeridian())
+	assert.False(t, square.CrossesAntimeridian())
+
+	assert.False(t, MustPolygon(LinearRings{square}).CrossesAntimeridian())
+	assert.True(t, MustPolygon(LinearRings{
+		{{150, -20}, {-150, -20}, {-150, 20}, {150, 20}, {150, -20}},
+		{{170, -10}, {170, 10}, {175, 10}, {175, -10}, {170, -10}},
+	}).CrossesAntimeridian(), "the outer ring crosses")
+}
+
+func TestPolygon_SplitAtAntimeridian(t *testing.T) {
+	t.Run("crossing polygon", func(t *testing.T) {
+		p := MustPolygon(LinearRings{{{170, -10}, {-170, -10}, {-170, 10}, {170, 10}, {170, -10}}})
+
+		m := p.SplitAtAntimeridian()
+		assert.Equal(t, []LinearRings{
+			{{{-180, -10}, {-170, -10}, {-170, 10}, {-180, 10}, {-180, -10}}},
+			{{{170, -10}, {180, -10}, {180, 10}, {170, 10}, {170, -10}}},
+		}, normalizeRingStarts(m.LinearRingsSlice()))
+		assert.InDelta(t, 400, m.Area(), 1e-9)
+	})
+
+	t.Run("hole on the other side", func(t *testing.T) {
+		p := MustPolygon(LinearRings{
+			{{170, -10}, {-170, -10}, {-170, 10}, {170, 10}, {170, -10}},
+			{{-178, -2}, {-174, -2}, {-174, 2}, {-178, 2}, {-178, -2}},
+		})
+
+		m := p.SplitAtAntimeridian()
+		assert.Len(t, m.LinearRingsSlice(), 2)
+		assert.InDelta(t, 400-16, m.Area(), 1e-9)
+		for _, rings := range m.LinearRingsSlice() {
+			for _, c := range Vertices(rings[0]) {
+				assert.LessOrEqual(t, c.Longitude(), 180.0)
+				assert.GreaterOrEqual(t, c.Longitude(), -180.0)
+			}
+		}
+	})
+
+	t.Run("no crossing", func(t *testing.T) {
+		p := MustPolygon(LinearRings{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}})
+		assert.Equal(t, []LinearRings{p.LinearRings()}, p.SplitAtAntimeridian().LinearRingsSlice())
+	})
+
+	t.Run("enclosing a pole", func(t *testing.T) {
+		p := MustPolygon(LinearRings{{{-180, 80}, {-60, 80}, {60, 80}, {179, 80}, {-120, 85}, {-180, 80}}})
+		assert.Equal(t, []LinearRings{p.LinearRings()}, p.SplitAtAntimeridian().LinearRingsSlice())
+	})
+}
+
+// normalizeRingStarts rotates each ring so that it starts from its south-western position,
+// making the comparison independent of where clipping starts the rings.
+func normalizeRingStarts(slice []LinearRings) []LinearRings {
+	for _, rings := range slice {
+		for i, ring := range rings {
+			start := 0
+			for j, c := range ring[:len(ring)-1] {
+				s := ring[start]
+				if c.Longitude() < s.Longitude() || (c.Longitude() == s.Longitude() && c.Latitude() < s.Latitude()) {
+					start = j
+				}
+			}
+			open := append(Vertices(ring[start:len(ring)-1]), ring[:start]...)
+			rings[i] = LinearRing(append(open, open[0]))
+		}
+	}
+	return slice
+}
+
+func TestGeometryObject_GeographicBoundingBox(t *testing.T) {
+	tests := []struct {
+		name     string
+		geometry Geometry
+		expected BoundingBox
+	}{
+		{
+			name:     "across the antimeridian",
+			geometry: NewMultiPointFromVertices(Vertices{{179, 10}, {-179, -10}}),
+			expected: BoundingBox{179, -10, -179, 10},
+		},
+		{
+			name:     "pacific line with altitude",
+			geometry: MustLineString(Vertices{{170, 0, 5}, {-170, 10, 1}, {-160, 5, 3}}),
+			expected: BoundingBox{170, 0, 1, -160, 10, 5},
+		},
+		{
+			name:     "regular extent",
+			geometry: MustLineString(Vertices{{-10, 0}, {10, 5}}),
+			expected: BoundingBox{-10, 0, 10, 5},
+		},
+		{
+			name:     "extent wider than half the globe",
+			geometry: NewMultiPointFromVertices(Vertices{{-120, 0}, {0, 0}, {120, 0}}),
+			expected: BoundingBox{-120, 0, 120, 0},
+		},
+		{
+			name: "collection",
+			geometry: NewGeometryCollectionFromSlice([]Geometry{
+				MustPoint([]float64{178, 1}),
+				MustPolygon(LinearRings{{{-178, 0}, {-175, 0}, {-175, 2}, {-178, 0}}}),
+			}),
+			expected: BoundingBox{178, 0, -175, 2},
+		},
+		{
+			name:     "single point",
+			geometry: MustPoint([]float64{180, 0}),
+			expected: BoundingBox{180, 0, 180, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := FromGeometry(tt.geometry)
+			assert.Equal(t, tt.expected, g.GeographicBoundingBox())
+		})
+	}
+
+	assert.Empty(t, (&GeometryObject{}).GeographicBoundingBox())
+}
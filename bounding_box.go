@@ -1,7 +1,9 @@
 package geojson
 
 import (
+	"errors"
 	"math"
+	"slices"
 )
 
 const (
@@ -11,6 +13,27 @@ const (
 	bboxSize3D = 6
 )
 
+// Indexes of the values of a bounding box. A 2D bounding box is laid out as [minLng, minLat, maxLng, maxLat],
+// and a 3D one as [minLng, minLat, minAlt, maxLng, maxLat, maxAlt].
+const (
+	idxBBoxMinLng   = 0
+	idxBBoxMinLat   = 1
+	idxBBox2DMaxLng = 2
+	idxBBox2DMaxLat = 3
+	idxBBox3DMinAlt = 2
+	idxBBox3DMaxLng = 3
+	idxBBox3DMaxLat = 4
+	idxBBox3DMaxAlt = 5
+)
+
+var (
+	// ErrInvalidBBox is returned when a bounding box does not have 4 or 6 elements.
+	ErrInvalidBBox = errors.New("bounding box must have 4 or 6 elements")
+
+	// ErrEmptyBBox is returned when an operation requires a bounding box with values and it is empty.
+	ErrEmptyBBox = errors.New("bounding box is empty")
+)
+
 // BoundingBoxer is an interface that defines methods for calculating the bounding box
 // and retrieving the vertices of a geometry.
 type BoundingBoxer interface {
@@ -20,7 +43,8 @@ type BoundingBoxer interface {
 	Vertices() Vertices
 }
 
-// BoundingBox represents a geographic bounding box, either 2D or 3D, as a slice of float64 values.
+// BoundingBox represents a geographic bounding box, either 2D or 3D, as a slice of float64 values:
+// [minLng, minLat, maxLng, maxLat] or [minLng, minLat, minAlt, maxLng, maxLat, maxAlt].
 type BoundingBox []float64
 
 // Is2D checks if the bounding box is a valid 2D bounding box.
@@ -43,6 +67,237 @@ func (b *BoundingBox) IsValid() bool {
 	return b.IsZero() || b.Is2D() || b.Is3D()
 }
 
+// Intersects reports whether the bounding box and the other one overlap or touch,
+// comparing only their longitude and latitude extents, so a 2D box can be compared with a 3D one.
+// It returns false if either bounding box is empty or is neither 2D nor 3D.
+func (b BoundingBox) Intersects(other BoundingBox) bool {
+	e, ok := newBoxExtent(b)
+	if !ok {
+		return false
+	}
+
+	o, ok := newBoxExtent(other)
+	if !ok {
+		return false
+	}
+
+	return e.minLng <= o.maxLng && o.minLng <= e.maxLng &&
+		e.minLat <= o.maxLat && o.minLat <= e.maxLat
+}
+
+// Contains reports whether the other bounding box lies entirely within the bounding box, boundary included,
+// comparing only their longitude and latitude extents, so a 2D box can be compared with a 3D one.
+// It returns false if either bounding box is empty or is neither 2D nor 3D.
+func (b BoundingBox) Contains(other BoundingBox) bool {
+	e, ok := newBoxExtent(b)
+	if !ok {
+		return false
+	}
+
+	o, ok := newBoxExtent(other)
+	if !ok {
+		return false
+	}
+
+	return e.minLng <= o.minLng && o.maxLng <= e.maxLng &&
+		e.minLat <= o.minLat && o.maxLat <= e.maxLat
+}
+
+// Intersects3D reports whether the bounding box and the other one overlap or touch like Intersects, and,
+// when both are 3D, also requires their altitude ranges to overlap or touch. A 2D box is treated as
+// unbounded in altitude. It returns false if either bounding box is empty or is neither 2D nor 3D.
+func (b BoundingBox) Intersects3D(other BoundingBox) bool {
+	if !b.Intersects(other) {
+		return false
+	}
+
+	minAlt, maxAlt := altitudeRange(b)
+	otherMinAlt, otherMaxAlt := altitudeRange(other)
+
+	return minAlt <= otherMaxAlt && otherMinAlt <= maxAlt
+}
+
+// ToPolygon returns the longitude and latitude extent of the bounding box as a Polygon with a single,
+// closed, counterclockwise ring of 5 positions starting at the south-west corner. The altitude of a 3D box
+// is ignored. It returns ErrInvalidBBox if the bounding box is neither 2D nor 3D, or if a minimum exceeds
+// its maximum, as in a box crossing the antimeridian.
+func (b BoundingBox) ToPolygon() (*Polygon, error) {
+	e, ok := newBoxExtent(b)
+	if !ok || e.minLng > e.maxLng || e.minLat > e.maxLat {
+		return nil, ErrInvalidBBox
+	}
+
+	ring := LinearRing{
+		{e.minLng, e.minLat},
+		{e.maxLng, e.minLat},
+		{e.maxLng, e.maxLat},
+		{e.minLng, e.maxLat},
+		{e.minLng, e.minLat},
+	}
+
+	return &Polygon{rings: LinearRings{ring}}, nil
+}
+
+// Center returns the midpoint of the longitude and latitude extent of the bounding box as a Point, with the
+// midpoint of the altitude range for a 3D box. For a box crossing the antimeridian, whose minimum longitude
+// exceeds its maximum as returned by GeometryObject.GeographicBoundingBox, the midpoint is taken across ±180.
+// It returns ErrEmptyBBox for an empty bounding box, ErrInvalidBBox if it is neither 2D nor 3D or its minimum
+// latitude exceeds its maximum, and the errors of NewPoint if the midpoint is out of range.
+func (b BoundingBox) Center() (*Point, error) {
+	if b.IsZero() {
+		return nil, ErrEmptyBBox
+	}
+
+	e, ok := newBoxExtent(b)
+	if !ok || e.minLat > e.maxLat {
+		return nil, ErrInvalidBBox
+	}
+
+	lng := (e.minLng + e.maxLng) / 2
+	if e.minLng > e.maxLng {
+		lng += LongitudeMax
+		if lng > LongitudeMax {
+			lng -= 2 * LongitudeMax
+		}
+	}
+
+	v := []float64{lng, (e.minLat + e.maxLat) / 2}
+	if b.Is3D() {
+		v = append(v, (b[idxBBox3DMinAlt]+b[idxBBox3DMaxAlt])/2)
+	}
+
+	return NewPoint(v)
+}
+
+// Expand returns a copy of the bounding box grown by the margin, in degrees, on all sides, with longitudes
+// clamped to ±180 and latitudes to ±90. The altitude range of a 3D box is grown by the same amount.
+// A negative margin shrinks the box, collapsing any range narrower than twice the margin to its middle.
+// Empty bounding boxes, and those that are neither 2D nor 3D, are returned unchanged.
+func (b BoundingBox) Expand(degrees float64) BoundingBox {
+	out := slices.Clone(b)
+
+	maxLng, maxLat := idxBBox2DMaxLng, idxBBox2DMaxLat
+	switch {
+	case b.Is2D():
+	case b.Is3D():
+		maxLng, maxLat = idxBBox3DMaxLng, idxBBox3DMaxLat
+		expandRange(out, idxBBox3DMinAlt, idxBBox3DMaxAlt, degrees, math.Inf(-1), math.Inf(1))
+	default:
+		return out
+	}
+
+	expandRange(out, idxBBoxMinLng, maxLng, degrees, LongitudeMin, LongitudeMax)
+	expandRange(out, idxBBoxMinLat, maxLat, degrees, LatitudeMin, LatitudeMax)
+
+	return out
+}
+
+// expandRange grows the range of the bounding box between the minimum and maximum indexes by the margin
+// on both sides, clamped to the given limits. When a negative margin inverts it, the range collapses to its middle.
+func expandRange(b BoundingBox, minIdx, maxIdx int, margin, lower, upper float64) {
+	minVal, maxVal := b[minIdx]-margin, b[maxIdx]+margin
+	if margin < 0 && minVal > maxVal {
+		minVal = (b[minIdx] + b[maxIdx]) / 2
+		maxVal = minVal
+	}
+
+	b[minIdx] = math.Max(lower, math.Min(upper, minVal))
+	b[maxIdx] = math.Max(lower, math.Min(upper, maxVal))
+}
+
+// UnionBoundingBoxes returns the smallest bounding box containing all the given ones, without walking any
+// geometry. The result is 3D if any input is 3D, in which case 2D inputs count as lying at altitude 0,
+// as positions without altitude do when computing the bounding box of a geometry. Empty bounding boxes,
+// and those that are neither 2D nor 3D, are skipped, and an empty bounding box is returned if none is left.
+// Boxes crossing the antimeridian, whose minimum longitude exceeds the maximum, are not supported.
+func UnionBoundingBoxes(boxes ...BoundingBox) BoundingBox {
+	var corners Vertices
+	for _, b := range boxes {
+		switch {
+		case b.Is2D():
+			corners = append(corners,
+				Coordinates{b[idxBBoxMinLng], b[idxBBoxMinLat]},
+				Coordinates{b[idxBBox2DMaxLng], b[idxBBox2DMaxLat]})
+		case b.Is3D():
+			corners = append(corners,
+				Coordinates{b[idxBBoxMinLng], b[idxBBoxMinLat], b[idxBBox3DMinAlt]},
+				Coordinates{b[idxBBox3DMaxLng], b[idxBBox3DMaxLat], b[idxBBox3DMaxAlt]})
+		}
+	}
+
+	return bbox(corners)
+}
+
+// declaredBBox holds the bounding box declared in the decoded GeoJSON of a geometry, if any.
+// It is embedded by every geometry type.
+type declaredBBox struct {
+	bbox BoundingBox // bbox is the bounding box declared in the decoded GeoJSON, if any.
+}
+
+// ParsedBBox returns the bounding box declared in the decoded GeoJSON of the geometry,
+// and a boolean indicating whether one was present.
+func (d *declaredBBox) ParsedBBox() (BoundingBox, bool) {
+	return d.bbox, len(d.bbox) > 0
+}
+
+// serializedBBox returns the bounding box to include in the GeoJSON output of the geometry g:
+// the computed one if serialize is true, the declared one if preserve is true, or nil.
+func (d *declaredBBox) serializedBBox(g BoundingBoxer, serialize, preserve bool) BoundingBox {
+	if serialize {
+		return g.BoundingBox()
+	}
+	if preserve {
+		return d.bbox
+	}
+	return nil
+}
+
+// boxExtent represents the longitude and latitude extent of a bounding box.
+type boxExtent struct {
+	minLng, minLat, maxLng, maxLat float64
+}
+
+// newBoxExtent creates a boxExtent from the 2D extent of a bounding box.
+// It returns false if the bounding box is neither 2D nor 3D.
+func newBoxExtent(b BoundingBox) (boxExtent, bool) {
+	switch {
+	case b.Is2D():
+		return boxExtent{b[idxBBoxMinLng], b[idxBBoxMinLat], b[idxBBox2DMaxLng], b[idxBBox2DMaxLat]}, true
+	case b.Is3D():
+		return boxExtent{b[idxBBoxMinLng], b[idxBBoxMinLat], b[idxBBox3DMaxLng], b[idxBBox3DMaxLat]}, true
+	default:
+		return boxExtent{}, false
+	}
+}
+
+// splitAtAntimeridian returns the 2D extent of a bounding box crossing the antimeridian, whose minimum longitude
+// exceeds its maximum, as the two boxes on either side of it. Any other bounding box is returned unchanged.
+func splitAtAntimeridian(b BoundingBox) []BoundingBox {
+	e, ok := newBoxExtent(b)
+	if !ok || e.minLng <= e.maxLng {
+		return []BoundingBox{b}
+	}
+
+	return []BoundingBox{
+		{e.minLng, e.minLat, LongitudeMax, e.maxLat},
+		{-LongitudeMax, e.minLat, e.maxLng, e.maxLat},
+	}
+}
+
+// altitudeRange returns the altitude range of a 3D bounding box, or an unbounded range for any other box.
+func altitudeRange(b BoundingBox) (minAlt, maxAlt float64) {
+	if b.Is3D() {
+		return b[idxBBox3DMinAlt], b[idxBBox3DMaxAlt]
+	}
+	return math.Inf(-1), math.Inf(1)
+}
+
+// contains reports whether the longitude and latitude of the position lie within the extent, boundary included.
+func (e boxExtent) contains(c Coordinates) bool {
+	return e.minLng <= c[idxCoordsLng] && c[idxCoordsLng] <= e.maxLng &&
+		e.minLat <= c[idxCoordsLat] && c[idxCoordsLat] <= e.maxLat
+}
+
 // updateRange updates the minimum and maximum float64 values based on the provided value.
 func updateRange(value float64, minVal, maxVal *float64) {
 	if value < *minVal {
@@ -63,7 +318,8 @@ func bbox(vertices Vertices) BoundingBox {
 	}
 
 	// Initialize the minimum and maximum values for longitude, latitude, and altitude.
-	minLng, minLat, maxLng, maxLat := LongitudeMax, LatitudeMax, LongitudeMin, LatitudeMin
+	// Longitude and latitude are not bounded to their valid ranges, so that projected positions are supported.
+	minLng, minLat, maxLng, maxLat := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
 	minAlt, maxAlt := math.MaxFloat64, -math.MaxFloat64
 
 	altitudeCount := 0 // Tracks the number of vertices with altitude information.
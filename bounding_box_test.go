@@ -1,6 +1,7 @@
 package geojson
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -146,3 +147,179 @@ func TestBbox(t *testing.T) {
 		})
 	}
 }
+
+func TestBoundingBox_Intersects(t *testing.T) {
+	tests := []struct {
+		name     string
+		bbox     BoundingBox
+		other    BoundingBox
+		expected bool
+	}{
+		{"overlapping", BoundingBox{0, 0, 2, 2}, BoundingBox{1, 1, 3, 3}, true},
+		{"touching edges", BoundingBox{0, 0, 1, 1}, BoundingBox{1, 0, 2, 1}, true},
+		{"disjoint in longitude", BoundingBox{0, 0, 1, 1}, BoundingBox{2, 0, 3, 1}, false},
+		{"disjoint in latitude", BoundingBox{0, 0, 1, 1}, BoundingBox{0, 2, 1, 3}, false},
+		{"2D and 3D", BoundingBox{0, 0, 2, 2}, BoundingBox{1, 1, 100, 3, 3, 200}, true},
+		{"3D boxes with disjoint altitudes", BoundingBox{0, 0, 0, 2, 2, 1}, BoundingBox{1, 1, 5, 3, 3, 6}, true},
+		{"empty", BoundingBox{}, BoundingBox{0, 0, 1, 1}, false},
+		{"invalid", BoundingBox{0, 0, 1, 1}, BoundingBox{0, 0, 1}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.bbox.Intersects(tt.other))
+		})
+	}
+}
+
+func TestBoundingBox_Intersects3D(t *testing.T) {
+	tests := []struct {
+		name     string
+		bbox     BoundingBox
+		other    BoundingBox
+		expected bool
+	}{
+		{"3D boxes with overlapping altitudes", BoundingBox{0, 0, 0, 2, 2, 10}, BoundingBox{1, 1, 5, 3, 3, 20}, true},
+		{"3D boxes with touching altitudes", BoundingBox{0, 0, 0, 2, 2, 10}, BoundingBox{1, 1, 10, 3, 3, 20}, true},
+		{"3D boxes with disjoint altitudes", BoundingBox{0, 0, 0, 2, 2, 1}, BoundingBox{1, 1, 5, 3, 3, 6}, false},
+		{"3D boxes disjoint in longitude", BoundingBox{0, 0, 0, 1, 1, 10}, BoundingBox{2, 0, 0, 3, 1, 10}, false},
+		{"2D and 3D", BoundingBox{0, 0, 2, 2}, BoundingBox{1, 1, 100, 3, 3, 200}, true},
+		{"3D and 2D", BoundingBox{1, 1, 100, 3, 3, 200}, BoundingBox{0, 0, 2, 2}, true},
+		{"2D boxes", BoundingBox{0, 0, 2, 2}, BoundingBox{1, 1, 3, 3}, true},
+		{"empty", BoundingBox{}, BoundingBox{0, 0, 0, 1, 1, 1}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.bbox.Intersects3D(tt.other))
+		})
+	}
+}
+
+func TestBoundingBox_Contains(t *testing.T) {
+	tests := []struct {
+		name     string
+		bbox     BoundingBox
+		other    BoundingBox
+		expected bool
+	}{
+		{"inside", BoundingBox{0, 0, 10, 10}, BoundingBox{1, 1, 3, 3}, true},
+		{"equal", BoundingBox{0, 0, 10, 10}, BoundingBox{0, 0, 10, 10}, true},
+		{"overlapping", BoundingBox{0, 0, 2, 2}, BoundingBox{1, 1, 3, 3}, false},
+		{"larger", BoundingBox{1, 1, 3, 3}, BoundingBox{0, 0, 10, 10}, false},
+		{"3D and 2D", BoundingBox{0, 0, -10, 10, 10, 10}, BoundingBox{1, 1, 3, 3}, true},
+		{"empty", BoundingBox{0, 0, 10, 10}, BoundingBox{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.bbox.Contains(tt.other))
+		})
+	}
+}
+
+func TestBoundingBox_ToPolygon(t *testing.T) {
+	square := MustPolygon(LinearRings{*MustLinearRing(Vertices{{0, 0}, {10, 0}, {10, 5}, {0, 5}, {0, 0}})})
+
+	tests := []struct {
+		name        string
+		bbox        BoundingBox
+		expected    *Polygon
+		expectedErr error
+	}{
+		{"2D", BoundingBox{0, 0, 10, 5}, square, nil},
+		{"3D", BoundingBox{0, 0, -100, 10, 5, 100}, square, nil},
+		{"empty", BoundingBox{}, nil, ErrInvalidBBox},
+		{"wrong size", BoundingBox{0, 0, 10}, nil, ErrInvalidBBox},
+		{"crossing the antimeridian", BoundingBox{170, 0, -170, 5}, nil, ErrInvalidBBox},
+		{"inverted latitude", BoundingBox{0, 5, 10, 0}, nil, ErrInvalidBBox},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			polygon, err := tt.bbox.ToPolygon()
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expected, polygon)
+		})
+	}
+}
+
+func TestBoundingBox_Center(t *testing.T) {
+	tests := []struct {
+		name        string
+		box         BoundingBox
+		expected    *Point
+		expectedErr error
+	}{
+		{"2D", BoundingBox{10, 20, 30, 40}, MustPoint([]float64{20, 30}), nil},
+		{"3D", BoundingBox{10, 20, 100, 30, 40, 200}, MustPoint([]float64{20, 30, 150}), nil},
+		{"degenerate", BoundingBox{5, 5, 5, 5}, MustPoint([]float64{5, 5}), nil},
+		{"antimeridian", BoundingBox{170, -10, -150, 10}, MustPoint([]float64{-170, 0}), nil},
+		{"antimeridian east", BoundingBox{150, -10, -170, 10}, MustPoint([]float64{170, 0}), nil},
+		{"empty", BoundingBox{}, nil, ErrEmptyBBox},
+		{"invalid size", BoundingBox{1, 2, 3}, nil, ErrInvalidBBox},
+		{"inverted latitude", BoundingBox{10, 40, 30, 20}, nil, ErrInvalidBBox},
+		{"out of range", BoundingBox{200, 0, 300, 10}, nil, ErrLongitudeRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			center, err := tt.box.Center()
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expected, center)
+		})
+	}
+}
+
+func TestBoundingBox_Expand(t *testing.T) {
+	tests := []struct {
+		name     string
+		box      BoundingBox
+		degrees  float64
+		expected BoundingBox
+	}{
+		{"2D", BoundingBox{10, 20, 30, 40}, 1.5, BoundingBox{8.5, 18.5, 31.5, 41.5}},
+		{"3D", BoundingBox{10, 20, 100, 30, 40, 200}, 1, BoundingBox{9, 19, 99, 31, 41, 201}},
+		{"clamped", BoundingBox{-179, -89, 179.5, 88}, 2, BoundingBox{-180, -90, 180, 90}},
+		{"shrunk", BoundingBox{10, 20, 30, 40}, -5, BoundingBox{15, 25, 25, 35}},
+		{"collapsed", BoundingBox{10, 20, 12, 40}, -5, BoundingBox{11, 25, 11, 35}},
+		{"empty", BoundingBox{}, 1, BoundingBox{}},
+		{"invalid size", BoundingBox{1, 2, 3}, 1, BoundingBox{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			box := slices.Clone(tt.box)
+			assert.Equal(t, tt.expected, box.Expand(tt.degrees))
+			assert.Equal(t, tt.box, box, "the bounding box is not modified")
+		})
+	}
+}
+
+func TestUnionBoundingBoxes(t *testing.T) {
+	tests := []struct {
+		name     string
+		boxes    []BoundingBox
+		expected BoundingBox
+	}{
+		{"none", nil, BoundingBox{}},
+		{"single", []BoundingBox{{1, 2, 3, 4}}, BoundingBox{1, 2, 3, 4}},
+		{"2D", []BoundingBox{{1, 2, 3, 4}, {-5, 3, 2, 10}}, BoundingBox{-5, 2, 3, 10}},
+		{"3D", []BoundingBox{{1, 2, 10, 3, 4, 20}, {0, 0, 5, 1, 1, 8}}, BoundingBox{0, 0, 5, 3, 4, 20}},
+		{"mixed", []BoundingBox{{1, 2, 3, 4}, {0, 0, 5, 1, 1, 8}}, BoundingBox{0, 0, 0, 3, 4, 8}},
+		{"skipped", []BoundingBox{{}, {1, 2, 3}, {1, 2, 3, 4}, nil}, BoundingBox{1, 2, 3, 4}},
+		{"only skipped", []BoundingBox{{}, {1, 2, 3}}, BoundingBox{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, UnionBoundingBoxes(tt.boxes...))
+		})
+	}
+
+	fc := NewFeatureCollectionFromFeatures([]Feature{
+		{Geometry: MustPoint([]float64{1, 2, 3})},
+		{Geometry: MustLineString(Vertices{{-4, 5}, {6, -7}})},
+	})
+	assert.Equal(t, fc.BoundingBox(), UnionBoundingBoxes(fc.Features[0].BoundingBox(), fc.Features[1].BoundingBox()))
+}
@@ -0,0 +1,262 @@
+package geojson
+
+import (
+	"slices"
+)
+
+const (
+	// Outcodes used by the Cohen-Sutherland algorithm to locate a position relative to a clipping box.
+	outcodeInside = 0
+	outcodeLeft   = 1
+	outcodeRight  = 2
+	outcodeBottom = 4
+	outcodeTop    = 8
+)
+
+// outcode computes the Cohen-Sutherland region code of a position.
+func (cb boxExtent) outcode(lng, lat float64) int {
+	code := outcodeInside
+	if lng < cb.minLng {
+		code |= outcodeLeft
+	} else if lng > cb.maxLng {
+		code |= outcodeRight
+	}
+	if lat < cb.minLat {
+		code |= outcodeBottom
+	} else if lat > cb.maxLat {
+		code |= outcodeTop
+	}
+	return code
+}
+
+// clipSegment clips the segment from a to b against the box using the Cohen-Sutherland algorithm.
+// It returns the clipped endpoints and false if the segment lies entirely outside the box.
+func (cb boxExtent) clipSegment(a, b Coordinates) (Coordinates, Coordinates, bool) {
+	codeA := cb.outcode(a.Longitude(), a.Latitude())
+	codeB := cb.outcode(b.Longitude(), b.Latitude())
+	start, end := a, b
+
+	for {
+		switch {
+		case codeA|codeB == outcodeInside:
+			return start, end, true
+		case codeA&codeB != outcodeInside:
+			return nil, nil, false
+		}
+
+		// Pick an endpoint that lies outside the box and move it onto the box edge.
+		code := codeA
+		if code == outcodeInside {
+			code = codeB
+		}
+
+		dLng := b.Longitude() - a.Longitude()
+		dLat := b.Latitude() - a.Latitude()
+
+		var t float64
+		switch {
+		case code&outcodeTop != 0:
+			t = (cb.maxLat - a.Latitude()) / dLat
+		case code&outcodeBottom != 0:
+			t = (cb.minLat - a.Latitude()) / dLat
+		case code&outcodeRight != 0:
+			t = (cb.maxLng - a.Longitude()) / dLng
+		default:
+			t = (cb.minLng - a.Longitude()) / dLng
+		}
+
+		p := interpolateLinear(a, b, t)
+		if code == codeA {
+			start, codeA = p, cb.outcode(p.Longitude(), p.Latitude())
+		} else {
+			end, codeB = p, cb.outcode(p.Longitude(), p.Latitude())
+		}
+	}
+}
+
+// clipRing clips a closed ring against the box using the Sutherland-Hodgman algorithm.
+// The result is closed and free of consecutive duplicates, or nil if the ring
+// does not keep at least 3 distinct positions inside the box.
+func (cb boxExtent) clipRing(ring LinearRing) LinearRing {
+	// Work on a copy of the open ring, without the closing position, so that the result
+	// shares no positions with the input.
+	out := cloneVertices(Vertices(ring[:len(ring)-1]))
+
+	edges := []struct {
+		inside    func(c Coordinates) bool
+		intersect func(a, b Coordinates) Coordinates
+	}{
+		{
+			inside: func(c Coordinates) bool { return c.Longitude() >= cb.minLng },
+			intersect: func(a, b Coordinates) Coordinates {
+				return onBoxEdge(interpolateLinear(a, b, (cb.minLng-a.Longitude())/(b.Longitude()-a.Longitude())), idxCoordsLng, cb.minLng)
+			},
+		},
+		{
+			inside: func(c Coordinates) bool { return c.Longitude() <= cb.maxLng },
+			intersect: func(a, b Coordinates) Coordinates {
+				return onBoxEdge(interpolateLinear(a, b, (cb.maxLng-a.Longitude())/(b.Longitude()-a.Longitude())), idxCoordsLng, cb.maxLng)
+			},
+		},
+		{
+			inside: func(c Coordinates) bool { return c.Latitude() >= cb.minLat },
+			intersect: func(a, b Coordinates) Coordinates {
+				return onBoxEdge(interpolateLinear(a, b, (cb.minLat-a.Latitude())/(b.Latitude()-a.Latitude())), idxCoordsLat, cb.minLat)
+			},
+		},
+		{
+			inside: func(c Coordinates) bool { return c.Latitude() <= cb.maxLat },
+			intersect: func(a, b Coordinates) Coordinates {
+				return onBoxEdge(interpolateLinear(a, b, (cb.maxLat-a.Latitude())/(b.Latitude()-a.Latitude())), idxCoordsLat, cb.maxLat)
+			},
+		},
+	}
+
+	for _, edge := range edges {
+		in := out
+		out = nil
+		for i, current := range in {
+			previous := in[(i+len(in)-1)%len(in)]
+
+			switch {
+			case edge.inside(current) && edge.inside(previous):
+				out = append(out, current)
+			case edge.inside(current):
+				out = append(out, edge.intersect(previous, current), current)
+			case edge.inside(previous):
+				out = append(out, edge.intersect(previous, current))
+			}
+		}
+	}
+
+	out = removeConsecutiveDuplicates(out)
+	if len(out) > 1 && out[0].IsEqual(out[len(out)-1]) {
+		out = out[:len(out)-1]
+	}
+
+	// A ring needs at least 3 distinct positions, plus the closing one.
+	if len(out) < LinearRingMinimumSize-1 {
+		return nil
+	}
+
+	clipped := LinearRing(append(out, slices.Clone(out[0])))
+	if clipped.Area() == 0 {
+		return nil
+	}
+
+	return clipped
+}
+
+// ClipToBBox clips the LineString to the 2D extent of the bounding box using the Cohen-Sutherland algorithm.
+// Altitude is ignored by the clipping window and linearly interpolated at the new endpoints.
+// The result is a LineString if a single contiguous part remains inside the box, or a MultiLineString
+// if the LineString leaves and re-enters the box. It returns false if nothing remains inside the box
+// or if the bounding box is neither 2D nor 3D.
+func (l *LineString) ClipToBBox(bbox BoundingBox) (Geometry, bool) {
+	cb, ok := newBoxExtent(bbox)
+	if !ok {
+		return nil, false
+	}
+
+	var parts Segments
+	var current Vertices
+	for i := 0; i < len(l.vertices)-1; i++ {
+		start, end, ok := cb.clipSegment(l.vertices[i], l.vertices[i+1])
+		if !ok {
+			continue
+		}
+
+		// Start a new part when the clipped segment does not continue the current one.
+		if len(current) == 0 || !current[len(current)-1].IsEqual(start) {
+			parts = appendPart(parts, current)
+			current = Vertices{start}
+		}
+		if !end.IsEqual(start) {
+			current = append(current, end)
+		}
+	}
+	parts = appendPart(parts, current)
+
+	switch len(parts) {
+	case 0:
+		return nil, false
+	case 1:
+		return &LineString{vertices: parts[0]}, true
+	default:
+		return &MultiLineString{segments: parts}, true
+	}
+}
+
+// ClipToBBox clips the Polygon to the 2D extent of the bounding box using the Sutherland-Hodgman algorithm.
+// Each ring is clipped independently: inner rings that fall outside the box are dropped, and the resulting
+// rings are closed and oriented following the right-hand rule. Altitude is ignored by the clipping window and
+// linearly interpolated at the new positions. It returns false if the outer ring does not overlap the box
+// or if the bounding box is neither 2D nor 3D.
+func (p *Polygon) ClipToBBox(bbox BoundingBox) (Geometry, bool) {
+	cb, ok := newBoxExtent(bbox)
+	if !ok {
+		return nil, false
+	}
+
+	polygon, ok := p.clipToExtent(cb)
+	if !ok {
+		return nil, false
+	}
+
+	return polygon, true
+}
+
+// clipToExtent clips the Polygon to the extent, as described in ClipToBBox.
+func (p *Polygon) clipToExtent(cb boxExtent) (*Polygon, bool) {
+	if len(p.rings) == 0 {
+		return nil, false
+	}
+
+	outer := cb.clipRing(p.rings[0])
+	if outer == nil {
+		return nil, false
+	}
+
+	rings := LinearRings{outer}
+	for _, ring := range p.rings[1:] {
+		if inner := cb.clipRing(ring); inner != nil {
+			rings = append(rings, inner)
+		}
+	}
+
+	polygon, err := NewPolygon(rings)
+	if err != nil {
+		return nil, false
+	}
+
+	return polygon, true
+}
+
+// onBoxEdge sets a value of an intersection position exactly on the box edge it was computed for,
+// discarding the rounding error of the interpolation.
+func onBoxEdge(c Coordinates, idx int, value float64) Coordinates {
+	c[idx] = value
+	return c
+}
+
+// appendPart appends a part to the segments if it has at least the minimum number of vertices of a LineString.
+func appendPart(parts Segments, part Vertices) Segments {
+	if len(part) < LineStringMinimumSize {
+		return parts
+	}
+
+	return append(parts, part)
+}
+
+// removeConsecutiveDuplicates returns the vertices without consecutive positions that are equal.
+func removeConsecutiveDuplicates(v Vertices) Vertices {
+	out := make(Vertices, 0, len(v))
+	for _, c := range v {
+		if len(out) > 0 && out[len(out)-1].IsEqual(c) {
+			continue
+		}
+		out = append(out, c)
+	}
+
+	return out
+}
@@ -0,0 +1,202 @@
+package geojson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineString_ClipToBBox(t *testing.T) {
+	bbox := BoundingBox{0, 0, 10, 10}
+
+	tests := []struct {
+		name       string
+		lineString *LineString
+		bbox       BoundingBox
+		expected   Geometry
+		expectedOk bool
+	}{
+		{
+			name:       "fully inside",
+			lineString: MustLineString(Vertices{{1, 1}, {5, 5}, {9, 2}}),
+			bbox:       bbox,
+			expected:   MustLineString(Vertices{{1, 1}, {5, 5}, {9, 2}}),
+			expectedOk: true,
+		},
+		{
+			name:       "crossing the box",
+			lineString: MustLineString(Vertices{{-5, 5}, {15, 5}}),
+			bbox:       bbox,
+			expected:   MustLineString(Vertices{{0, 5}, {10, 5}}),
+			expectedOk: true,
+		},
+		{
+			name:       "leaving and re-entering the box",
+			lineString: MustLineString(Vertices{{2, 2}, {2, 20}, {8, 20}, {8, 2}}),
+			bbox:       bbox,
+			expected:   MustMultiLineString(Segments{{{2, 2}, {2, 10}}, {{8, 10}, {8, 2}}}),
+			expectedOk: true,
+		},
+		{
+			name:       "altitude is interpolated",
+			lineString: MustLineString(Vertices{{-10, 5, 0}, {10, 5, 100}}),
+			bbox:       bbox,
+			expected:   MustLineString(Vertices{{0, 5, 50}, {10, 5, 100}}),
+			expectedOk: true,
+		},
+		{
+			name:       "3D bounding box",
+			lineString: MustLineString(Vertices{{5, -5}, {5, 15}}),
+			bbox:       BoundingBox{0, 0, -100, 10, 10, 100},
+			expected:   MustLineString(Vertices{{5, 0}, {5, 10}}),
+			expectedOk: true,
+		},
+		{
+			name:       "fully outside",
+			lineString: MustLineString(Vertices{{20, 20}, {30, 30}}),
+			bbox:       bbox,
+			expectedOk: false,
+		},
+		{
+			name:       "touching a corner",
+			lineString: MustLineString(Vertices{{-5, 15}, {5, 5}}),
+			bbox:       BoundingBox{5, 5, 10, 10},
+			expectedOk: false,
+		},
+		{
+			name:       "invalid bounding box",
+			lineString: MustLineString(Vertices{{1, 1}, {5, 5}}),
+			bbox:       BoundingBox{0, 0, 10},
+			expectedOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, ok := tt.lineString.ClipToBBox(tt.bbox)
+			assert.Equal(t, tt.expectedOk, ok)
+			assert.Equal(t, tt.expected, g)
+		})
+	}
+}
+
+func TestPolygon_ClipToBBox(t *testing.T) {
+	bbox := BoundingBox{0, 0, 10, 10}
+
+	tests := []struct {
+		name         string
+		polygon      *Polygon
+		bbox         BoundingBox
+		expectedOk   bool
+		expectedArea float64
+		expectedBBox BoundingBox
+		expectedSize int
+	}{
+		{
+			name: "fully inside",
+			polygon: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{1, 1}, {9, 1}, {9, 9}, {1, 9}, {1, 1}}),
+			}),
+			bbox:         bbox,
+			expectedOk:   true,
+			expectedArea: 64,
+			expectedBBox: BoundingBox{1, 1, 9, 9},
+			expectedSize: 1,
+		},
+		{
+			name: "partially outside",
+			polygon: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}),
+			}),
+			bbox:         bbox,
+			expectedOk:   true,
+			expectedArea: 25,
+			expectedBBox: BoundingBox{5, 5, 10, 10},
+			expectedSize: 1,
+		},
+		{
+			name: "covering the box with a hole outside",
+			polygon: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{-10, -10}, {30, -10}, {30, 30}, {-10, 30}, {-10, -10}}),
+				*MustLinearRing(Vertices{{20, 20}, {25, 20}, {25, 25}, {20, 25}, {20, 20}}),
+			}),
+			bbox:         bbox,
+			expectedOk:   true,
+			expectedArea: 100,
+			expectedBBox: bbox,
+			expectedSize: 1,
+		},
+		{
+			name: "hole inside the box",
+			polygon: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{-10, -10}, {30, -10}, {30, 30}, {-10, 30}, {-10, -10}}),
+				*MustLinearRing(Vertices{{2, 2}, {4, 2}, {4, 4}, {2, 4}, {2, 2}}),
+			}),
+			bbox:         bbox,
+			expectedOk:   true,
+			expectedArea: 96,
+			expectedBBox: bbox,
+			expectedSize: 2,
+		},
+		{
+			name: "fully outside",
+			polygon: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{20, 20}, {30, 20}, {30, 30}, {20, 20}}),
+			}),
+			bbox:       bbox,
+			expectedOk: false,
+		},
+		{
+			name: "sharing an edge only",
+			polygon: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{10, 0}, {20, 0}, {20, 10}, {10, 10}, {10, 0}}),
+			}),
+			bbox:       bbox,
+			expectedOk: false,
+		},
+		{
+			name: "invalid bounding box",
+			polygon: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{1, 1}, {9, 1}, {9, 9}, {1, 1}}),
+			}),
+			bbox:       BoundingBox{0, 0},
+			expectedOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, ok := tt.polygon.ClipToBBox(tt.bbox)
+			assert.Equal(t, tt.expectedOk, ok)
+			if !tt.expectedOk {
+				assert.Nil(t, g)
+				return
+			}
+
+			p, ok := g.(*Polygon)
+			require.True(t, ok)
+			assert.Len(t, p.LinearRings(), tt.expectedSize)
+			for _, ring := range p.LinearRings() {
+				assert.True(t, ring.IsValid())
+			}
+			outer := p.OuterRing()
+			assert.True(t, outer.IsCounterClockwise())
+			assert.InDelta(t, tt.expectedArea, polygonArea(p.LinearRings()), 1e-9)
+			assert.Equal(t, tt.expectedBBox, p.BoundingBox())
+		})
+	}
+
+	t.Run("positions are not shared", func(t *testing.T) {
+		polygon := MustPolygon(LinearRings{
+			*MustLinearRing(Vertices{{1, 1}, {9, 1}, {9, 9}, {1, 9}, {1, 1}}),
+		})
+		g, ok := polygon.ClipToBBox(bbox)
+		require.True(t, ok)
+
+		ring := g.(*Polygon).rings[0]
+		ring[0][0] = 5
+		assert.Equal(t, Coordinates{1, 1}, ring[len(ring)-1], "the closing position is a separate copy")
+		assert.Equal(t, Vertices{{1, 1}, {9, 1}, {9, 9}, {1, 9}, {1, 1}}, polygon.Vertices())
+	})
+}
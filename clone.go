@@ -0,0 +1,172 @@
+package geojson
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+)
+
+// Clone returns a deep copy of the Feature. The geometry, including the rings and segments it holds,
+// the properties, with the maps and slices nested in them, the ID, and the foreign members are all copied,
+// so the copy can be modified without affecting the original.
+func (f *Feature) Clone() *Feature {
+	if f == nil {
+		return nil
+	}
+
+	return &Feature{
+		Geometry:       cloneGeometry(f.Geometry),
+		Properties:     cloneProperties(f.Properties),
+		ID:             f.ID.clone(),
+		SerializeBBox:  f.SerializeBBox,
+		ForeignMembers: cloneForeignMembers(f.ForeignMembers),
+		geometryAbsent: f.geometryAbsent,
+	}
+}
+
+// Clone returns a deep copy of the FeatureCollection, cloning every feature as Feature.Clone does.
+// The foreign members, the declared bounding box, and the way the features member was decoded are copied too.
+func (f *FeatureCollection) Clone() *FeatureCollection {
+	if f == nil {
+		return nil
+	}
+
+	clone := *f
+	clone.ForeignMembers = cloneForeignMembers(f.ForeignMembers)
+	clone.bbox = slices.Clone(f.bbox)
+
+	if f.Features != nil {
+		clone.Features = make([]Feature, len(f.Features))
+		for i := range f.Features {
+			clone.Features[i] = *f.Features[i].Clone()
+		}
+	}
+
+	return &clone
+}
+
+// cloneGeometry returns a deep copy of the geometry, including its declared bounding box and
+// serialization flags. GeometryCollections are copied recursively.
+func cloneGeometry(g Geometry) Geometry {
+	switch v := g.(type) {
+	case *Point:
+		clone := *v
+		clone.coords = slices.Clone(v.coords)
+		clone.bbox = slices.Clone(v.bbox)
+		return &clone
+	case *LineString:
+		clone := *v
+		clone.vertices = cloneVertices(v.vertices)
+		clone.bbox = slices.Clone(v.bbox)
+		return &clone
+	case *MultiPoint:
+		clone := *v
+		clone.vertices = cloneVertices(v.vertices)
+		clone.bbox = slices.Clone(v.bbox)
+		return &clone
+	case *MultiLineString:
+		clone := *v
+		clone.segments = mapSegments(v.segments, cloneVertices)
+		clone.bbox = slices.Clone(v.bbox)
+		return &clone
+	case *Polygon:
+		clone := *v
+		clone.rings = cloneLinearRings(v.rings)
+		clone.bbox = slices.Clone(v.bbox)
+		return &clone
+	case *MultiPolygon:
+		clone := *v
+		if v.rings != nil {
+			clone.rings = make([]LinearRings, len(v.rings))
+			for i, rings := range v.rings {
+				clone.rings[i] = cloneLinearRings(rings)
+			}
+		}
+		clone.bbox = slices.Clone(v.bbox)
+		return &clone
+	case *GeometryCollection:
+		clone := *v
+		if v.geometries != nil {
+			clone.geometries = make([]Geometry, len(v.geometries))
+			for i, child := range v.geometries {
+				clone.geometries[i] = cloneGeometry(child)
+			}
+		}
+		clone.bbox = slices.Clone(v.bbox)
+		return &clone
+	default:
+		return g
+	}
+}
+
+// mapSegments returns a new Segments collection with fn applied to each segment, or nil if segments is nil.
+func mapSegments(segments Segments, fn func(Vertices) Vertices) Segments {
+	if segments == nil {
+		return nil
+	}
+
+	out := make(Segments, len(segments))
+	for i, s := range segments {
+		out[i] = fn(s)
+	}
+	return out
+}
+
+// cloneLinearRings returns a deep copy of the rings, or nil if rings is nil.
+func cloneLinearRings(rings LinearRings) LinearRings {
+	if rings == nil {
+		return nil
+	}
+
+	return mapLinearRings(rings, cloneVertices)
+}
+
+// cloneProperties returns a deep copy of the properties, copying the maps and slices nested in the values.
+func cloneProperties(p Properties) Properties {
+	if p == nil {
+		return nil
+	}
+
+	out := make(Properties, len(p))
+	for key, value := range p {
+		out[key] = cloneValue(value)
+	}
+	return out
+}
+
+// cloneValue returns a deep copy of a property value. Maps and slices, as produced by decoding JSON or
+// holding strings, are copied recursively; other values are returned as they are.
+func cloneValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return map[string]interface{}(cloneProperties(v))
+	case Properties:
+		return cloneProperties(v)
+	case []interface{}:
+		if v == nil {
+			return v
+		}
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = cloneValue(item)
+		}
+		return out
+	case []string:
+		return slices.Clone(v)
+	default:
+		return value
+	}
+}
+
+// cloneForeignMembers returns a deep copy of the foreign members, or nil if members is nil.
+func cloneForeignMembers(members map[string]json.RawMessage) map[string]json.RawMessage {
+	if members == nil {
+		return nil
+	}
+
+	out := maps.Clone(members)
+	for key, value := range out {
+		out[key] = slices.Clone(value)
+	}
+	return out
+}
@@ -0,0 +1,118 @@
+package geojson
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeature_Clone(t *testing.T) {
+	polygon := MustPolygon(LinearRings{
+		*MustLinearRing(Vertices{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}),
+		*MustLinearRing(Vertices{{1, 1}, {1, 2}, {2, 2}, {2, 1}, {1, 1}}),
+	})
+	original := &Feature{
+		Geometry: polygon,
+		Properties: Properties{
+			"name": "a",
+			"tags": []interface{}{"x", map[string]interface{}{"k": 1.0}},
+			"meta": map[string]interface{}{"nested": []interface{}{1.0}},
+		},
+		ID:             NewIntID(7),
+		SerializeBBox:  true,
+		ForeignMembers: map[string]json.RawMessage{"title": json.RawMessage(`"t"`)},
+	}
+
+	clone := original.Clone()
+	require.True(t, clone.Equal(original))
+	assert.Equal(t, original.ForeignMembers, clone.ForeignMembers)
+	assert.True(t, clone.SerializeBBox)
+
+	clonedPolygon := clone.Geometry.(*Polygon)
+	clonedPolygon.rings[0][1][0] = 99
+	clonedPolygon.rings[1][0][1] = 99
+	clone.Properties["name"] = "b"
+	clone.Properties["tags"].([]interface{})[1].(map[string]interface{})["k"] = 2.0
+	clone.Properties["meta"].(map[string]interface{})["nested"].([]interface{})[0] = 2.0
+	*clone.ID.i = 8
+	clone.ForeignMembers["title"][1] = 'u'
+
+	assert.Equal(t, 4.0, polygon.rings[0][1][0])
+	assert.Equal(t, 1.0, polygon.rings[1][0][1])
+	assert.Equal(t, "a", original.Properties["name"])
+	assert.Equal(t, 1.0, original.Properties["tags"].([]interface{})[1].(map[string]interface{})["k"])
+	assert.Equal(t, 1.0, original.Properties["meta"].(map[string]interface{})["nested"].([]interface{})[0])
+	assert.True(t, original.ID.Equal(NewIntID(7)))
+	assert.Equal(t, json.RawMessage(`"t"`), original.ForeignMembers["title"])
+
+	assert.Nil(t, (*Feature)(nil).Clone())
+	assert.Equal(t, &Feature{}, (&Feature{}).Clone())
+}
+
+func TestFeature_Clone_Geometries(t *testing.T) {
+	var decoded GeometryObject
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"LineString","coordinates":[[0,0],[1,1]],"bbox":[0,0,1,1]}`), &decoded))
+	line, err := decoded.ToLineString()
+	require.NoError(t, err)
+
+	geometries := []Geometry{
+		MustPoint([]float64{1, 2, 3}),
+		line,
+		NewMultiPointFromVertices(Vertices{{0, 0}, {1, 1}}),
+		MustMultiLineString(Segments{{{0, 0}, {1, 1}}, {{2, 2}, {3, 3}}}),
+		MustMultiPolygonFromRingSlice([]LinearRings{
+			{*MustLinearRing(Vertices{{0, 0}, {1, 0}, {1, 1}, {0, 0}})},
+		}),
+		NewGeometryCollectionFromSlice([]Geometry{MustPoint([]float64{5, 5})}),
+	}
+
+	for _, g := range geometries {
+		t.Run(string(g.Type()), func(t *testing.T) {
+			clone := cloneGeometry(g)
+			require.True(t, GeometriesEqual(g, clone))
+
+			before := g.Vertices()[0][0]
+			walkGeometry(clone, func(c Coordinates) bool {
+				c[0] = 42
+				return true
+			})
+			assert.Equal(t, before, g.Vertices()[0][0])
+		})
+	}
+
+	clone := cloneGeometry(line).(*LineString)
+	bbox, ok := clone.ParsedBBox()
+	require.True(t, ok)
+	assert.Equal(t, BoundingBox{0, 0, 1, 1}, bbox)
+}
+
+func TestFeatureCollection_Clone(t *testing.T) {
+	var original FeatureCollection
+	data := `{"type":"FeatureCollection","bbox":[0,0,10,10],"title":"x","features":[` +
+		`{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":{"name":"a"}}]}`
+	require.NoError(t, json.Unmarshal([]byte(data), &original))
+
+	clone := original.Clone()
+	require.Len(t, clone.Features, 1)
+	assert.True(t, clone.Features[0].Equal(&original.Features[0]))
+	assert.Equal(t, original.ForeignMembers, clone.ForeignMembers)
+
+	bbox, ok := clone.ParsedBBox()
+	require.True(t, ok)
+	assert.Equal(t, BoundingBox{0, 0, 10, 10}, bbox)
+
+	clone.Features[0].Properties["name"] = "b"
+	clone.Features[0].Geometry.(*Point).coords[0] = 9
+	clone.bbox[0] = 5
+	clone.Features = append(clone.Features, Feature{})
+
+	assert.Equal(t, "a", original.Features[0].Properties["name"])
+	assert.Equal(t, Coordinates{1, 2}, original.Features[0].Geometry.(*Point).coords)
+	assert.Equal(t, BoundingBox{0, 0, 10, 10}, original.bbox)
+	assert.Len(t, original.Features, 1)
+
+	assert.Nil(t, (*FeatureCollection)(nil).Clone())
+	assert.Nil(t, NewFeatureCollection().Clone().Features)
+}
@@ -0,0 +1,125 @@
+package geojson
+
+import (
+	"fmt"
+	"slices"
+)
+
+// CollectPoints creates a new MultiPoint holding a copy of the positions of the points, in order, as the inverse
+// of Explode. It returns ErrGeometryNotDefined for nil points, and ErrCoordinatesSize, ErrLongitudeRange, or
+// ErrLatitudeRange for invalid positions, wrapped with the index of the point. Without arguments it returns
+// an empty MultiPoint.
+func CollectPoints(points ...*Point) (*MultiPoint, error) {
+	vertices := make(Vertices, 0, len(points))
+	for i, p := range points {
+		if p == nil {
+			return nil, fmt.Errorf("point %d: %w", i, ErrGeometryNotDefined)
+		}
+		if err := validatePosition(p.coords); err != nil {
+			return nil, fmt.Errorf("point %d: %w", i, err)
+		}
+
+		vertices = append(vertices, slices.Clone(p.coords))
+	}
+
+	return &MultiPoint{vertices: vertices}, nil
+}
+
+// MustCollectPoints creates a new MultiPoint from the points as CollectPoints does, and panics on error.
+func MustCollectPoints(points ...*Point) *MultiPoint {
+	m, err := CollectPoints(points...)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// CollectLineStrings creates a new MultiLineString holding a copy of the vertices of the line strings, in order,
+// as the inverse of Explode. It returns ErrGeometryNotDefined for nil line strings, and the errors of
+// NewMultiLineString for invalid ones or without arguments, or ErrCoordinatesSize, ErrLongitudeRange, or
+// ErrLatitudeRange for invalid positions, wrapped with the index of the line string.
+func CollectLineStrings(lineStrings ...*LineString) (*MultiLineString, error) {
+	segments := make(Segments, 0, len(lineStrings))
+	for i, l := range lineStrings {
+		if l == nil {
+			return nil, fmt.Errorf("line string %d: %w", i, ErrGeometryNotDefined)
+		}
+		if _, err := NewLineString(l.vertices); err != nil {
+			return nil, fmt.Errorf("line string %d: %w", i, err)
+		}
+		if err := validatePositions(l.vertices); err != nil {
+			return nil, fmt.Errorf("line string %d: %w", i, err)
+		}
+
+		segments = append(segments, cloneVertices(l.vertices))
+	}
+
+	return NewMultiLineString(segments)
+}
+
+// MustCollectLineStrings creates a new MultiLineString from the line strings as CollectLineStrings does,
+// and panics on error.
+func MustCollectLineStrings(lineStrings ...*LineString) *MultiLineString {
+	m, err := CollectLineStrings(lineStrings...)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// CollectPolygons creates a new MultiPolygon holding a copy of the rings of the polygons, in order, as the inverse
+// of Explode. The rings are oriented following the right-hand rule as NewMultiPolygon does. It returns
+// ErrGeometryNotDefined for nil polygons, and the errors of NewPolygon, or ErrCoordinatesSize, ErrLongitudeRange,
+// or ErrLatitudeRange for invalid positions, wrapped with the index of the polygon. Without arguments it returns
+// an empty MultiPolygon.
+func CollectPolygons(polygons ...*Polygon) (*MultiPolygon, error) {
+	for i, p := range polygons {
+		if p == nil {
+			return nil, fmt.Errorf("polygon %d: %w", i, ErrGeometryNotDefined)
+		}
+		if _, err := NewPolygonPreservingOrientation(p.rings); err != nil {
+			return nil, fmt.Errorf("polygon %d: %w", i, err)
+		}
+		for _, ring := range p.rings {
+			if err := validatePositions(Vertices(ring)); err != nil {
+				return nil, fmt.Errorf("polygon %d: %w", i, err)
+			}
+		}
+	}
+
+	return NewMultiPolygon(polygons...), nil
+}
+
+// MustCollectPolygons creates a new MultiPolygon from the polygons as CollectPolygons does, and panics on error.
+func MustCollectPolygons(polygons ...*Polygon) *MultiPolygon {
+	m, err := CollectPolygons(polygons...)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// validatePositions checks every position of the vertices as validatePosition does,
+// wrapping the error with the index of the first invalid one.
+func validatePositions(v Vertices) error {
+	for i, c := range v {
+		if err := validatePosition(c); err != nil {
+			return fmt.Errorf("position %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// validatePosition checks that the coordinates hold 2 or 3 values, or 4 with a measure,
+// and that their longitude and latitude are within range.
+func validatePosition(c Coordinates) error {
+	if !(decodeOptions{allowMeasure: true}).acceptsPositionSize(len(c)) {
+		return ErrCoordinatesSize
+	}
+
+	return validateCoordinates(c[idxCoordsLng], c[idxCoordsLat])
+}
@@ -0,0 +1,88 @@
+package geojson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectPoints(t *testing.T) {
+	a, b := MustPoint([]float64{1, 2}), MustPoint([]float64{3, 4, 5})
+
+	m, err := CollectPoints(a, b)
+	require.NoError(t, err)
+	assert.Equal(t, Vertices{{1, 2}, {3, 4, 5}}, m.Vertices())
+
+	m.Vertices()[0][0] = 9
+	assert.Equal(t, 1.0, a.Longitude(), "the positions should be copied")
+
+	m, err = CollectPoints()
+	require.NoError(t, err)
+	assert.Empty(t, m.Vertices())
+
+	_, err = CollectPoints(a, nil)
+	assert.ErrorIs(t, err, ErrGeometryNotDefined)
+	_, err = CollectPoints(&Point{coords: Coordinates{200, 0}})
+	assert.ErrorIs(t, err, ErrLongitudeRange)
+	_, err = CollectPoints(&Point{})
+	assert.ErrorIs(t, err, ErrCoordinatesSize)
+
+	assert.Panics(t, func() { MustCollectPoints(nil) })
+	assert.Equal(t, Vertices{{1, 2}}, MustCollectPoints(a).Vertices())
+}
+
+func TestCollectLineStrings(t *testing.T) {
+	a := MustLineString(Vertices{{0, 0}, {1, 1}})
+	b := MustLineString(Vertices{{2, 2}, {3, 3}, {4, 4}})
+
+	m, err := CollectLineStrings(a, b)
+	require.NoError(t, err)
+	assert.Equal(t, Segments{{{0, 0}, {1, 1}}, {{2, 2}, {3, 3}, {4, 4}}}, m.Segments())
+
+	m.Segments()[0][0][0] = 9
+	assert.Equal(t, Coordinates{0, 0}, a.Vertices()[0], "the vertices should be copied")
+
+	_, err = CollectLineStrings()
+	assert.ErrorIs(t, err, ErrMultiLineStringTooShort)
+	_, err = CollectLineStrings(a, nil)
+	assert.ErrorIs(t, err, ErrGeometryNotDefined)
+	_, err = CollectLineStrings(&LineString{vertices: Vertices{{0, 0}}})
+	assert.ErrorIs(t, err, ErrLineStringTooShort)
+	_, err = CollectLineStrings(&LineString{vertices: Vertices{{0, 0}, {200, 0}}})
+	assert.ErrorIs(t, err, ErrLongitudeRange)
+	_, err = CollectLineStrings(&LineString{vertices: Vertices{{0, 0}, {1, 1, 1, 1, 1}}})
+	assert.ErrorIs(t, err, ErrCoordinatesSize)
+
+	assert.Panics(t, func() { MustCollectLineStrings() })
+	assert.Equal(t, 1, MustCollectLineStrings(a).Len())
+}
+
+func TestCollectPolygons(t *testing.T) {
+	square := MustPolygon(LinearRings{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}})
+	clockwise, err := NewPolygonPreservingOrientation(LinearRings{{{5, 5}, {5, 6}, {6, 6}, {6, 5}, {5, 5}}})
+	require.NoError(t, err)
+
+	m, err := CollectPolygons(square, clockwise)
+	require.NoError(t, err)
+	require.Equal(t, 2, m.Len())
+	assert.Equal(t, square.LinearRings(), m.LinearRingsSlice()[0])
+	assert.True(t, m.LinearRingsSlice()[1][0].IsCounterClockwise(), "the rings should follow the right-hand rule")
+	assert.True(t, clockwise.LinearRings()[0].IsClockwise(), "the polygons should not be modified")
+
+	m, err = CollectPolygons()
+	require.NoError(t, err)
+	assert.Zero(t, m.Len())
+
+	_, err = CollectPolygons(square, nil)
+	assert.ErrorIs(t, err, ErrGeometryNotDefined)
+	_, err = CollectPolygons(&Polygon{})
+	assert.ErrorIs(t, err, ErrPolygonLinearRingCount)
+	_, err = CollectPolygons(&Polygon{rings: LinearRings{{{0, 0}, {1, 0}, {1, 1}, {0, 2}}}})
+	assert.ErrorIs(t, err, ErrLinearRingClosed)
+	_, err = CollectPolygons(&Polygon{rings: LinearRings{{{0, 0}, {1, 0}, {1, 95}, {0, 0}}}})
+	assert.ErrorIs(t, err, ErrLatitudeRange)
+
+	assert.Panics(t, func() { MustCollectPolygons(nil) })
+	assert.Equal(t, 1, MustCollectPolygons(square).Len())
+}
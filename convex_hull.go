@@ -0,0 +1,107 @@
+package geojson
+
+import (
+	"errors"
+	"slices"
+)
+
+const (
+	// convexHullMinimumPoints defines the minimum number of distinct, non-collinear points
+	// required to build a convex hull.
+	convexHullMinimumPoints = 3
+)
+
+var (
+	// ErrInsufficientPoints is returned when there are not enough distinct, non-collinear points
+	// to build a convex hull.
+	ErrInsufficientPoints = errors.New("at least 3 distinct non-collinear points are required")
+)
+
+// ConvexHull computes the convex hull of the provided vertices using the monotone chain (Andrew's) algorithm.
+// The hull is returned as a closed LinearRing ordered counterclockwise when counterClockwise is true,
+// or clockwise otherwise. Counterclockwise matches the orientation RFC 7946 requires for outer rings.
+// Altitude values are carried along with the hull vertices but are not used in the computation.
+// The hull holds copies of the vertices, so modifying it leaves the input unchanged.
+// Returns ErrInsufficientPoints if the vertices do not contain at least 3 distinct, non-collinear points.
+func ConvexHull(v Vertices, counterClockwise bool) (*LinearRing, error) {
+	points := cloneVertices(v)
+
+	// Sort the points lexicographically by longitude, then latitude.
+	slices.SortFunc(points, func(a, b Coordinates) int {
+		if a[idxCoordsLng] != b[idxCoordsLng] {
+			if a[idxCoordsLng] < b[idxCoordsLng] {
+				return -1
+			}
+			return 1
+		}
+		if a[idxCoordsLat] < b[idxCoordsLat] {
+			return -1
+		}
+		if a[idxCoordsLat] > b[idxCoordsLat] {
+			return 1
+		}
+		return 0
+	})
+
+	// Remove duplicated positions, comparing only longitude and latitude.
+	points = slices.CompactFunc(points, func(a, b Coordinates) bool {
+		return a[idxCoordsLng] == b[idxCoordsLng] && a[idxCoordsLat] == b[idxCoordsLat]
+	})
+
+	if len(points) < convexHullMinimumPoints {
+		return nil, ErrInsufficientPoints
+	}
+
+	// Build the lower and upper hulls, discarding points that do not make a counterclockwise turn.
+	hull := make(Vertices, 0, 2*len(points))
+	for _, p := range points {
+		for len(hull) >= 2 && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+
+	lowerLen := len(hull) + 1
+	for i := len(points) - 2; i >= 0; i-- {
+		p := points[i]
+		for len(hull) >= lowerLen && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+
+	// The last point is the first one, closing the ring. A closed ring of collinear
+	// points has fewer than 4 positions.
+	if len(hull) < LinearRingMinimumSize {
+		return nil, ErrInsufficientPoints
+	}
+	hull[len(hull)-1] = slices.Clone(hull[0])
+
+	ring := LinearRing(hull)
+	if !counterClockwise {
+		slices.Reverse(ring)
+	}
+
+	return &ring, nil
+}
+
+// ConvexHullPolygon returns the convex hull of the provided vertices as a Polygon whose single ring is closed
+// and counterclockwise, computed as ConvexHull does. Passing the output of Vertices hulls the positions of any
+// geometry. The positions of the Polygon are copies. Returns ErrInsufficientPoints if the vertices do not contain
+// at least 3 distinct, non-collinear points.
+func ConvexHullPolygon(v Vertices) (*Polygon, error) {
+	hull, err := ConvexHull(v, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Polygon{rings: LinearRings{*hull}}, nil
+}
+
+// cross returns the z component of the cross product of the vectors OA and OB.
+// A positive value indicates a counterclockwise turn, a negative value a clockwise turn,
+// and zero indicates that the points are collinear.
+func cross(o, a, b Coordinates) float64 {
+	return (a[idxCoordsLng]-o[idxCoordsLng])*(b[idxCoordsLat]-o[idxCoordsLat]) -
+		(a[idxCoordsLat]-o[idxCoordsLat])*(b[idxCoordsLng]-o[idxCoordsLng])
+}
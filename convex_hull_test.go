@@ -0,0 +1,98 @@
+package geojson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvexHull(t *testing.T) {
+	square := Vertices{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {5, 5}, {2, 8}}
+
+	tests := []struct {
+		name             string
+		vertices         Vertices
+		counterClockwise bool
+		expected         LinearRing
+		expectedErr      error
+	}{
+		{
+			name:             "square counterclockwise",
+			vertices:         square,
+			counterClockwise: true,
+			expected:         LinearRing{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		},
+		{
+			name:             "square clockwise",
+			vertices:         square,
+			counterClockwise: false,
+			expected:         LinearRing{{0, 0}, {0, 10}, {10, 10}, {10, 0}, {0, 0}},
+		},
+		{
+			name:             "duplicated points",
+			vertices:         Vertices{{0, 0}, {0, 0}, {4, 0}, {4, 0}, {2, 3}},
+			counterClockwise: true,
+			expected:         LinearRing{{0, 0}, {4, 0}, {2, 3}, {0, 0}},
+		},
+		{
+			name:             "collinear points",
+			vertices:         Vertices{{0, 0}, {1, 1}, {2, 2}, {3, 3}},
+			counterClockwise: true,
+			expectedErr:      ErrInsufficientPoints,
+		},
+		{
+			name:             "too few points",
+			vertices:         Vertices{{0, 0}, {1, 1}},
+			counterClockwise: true,
+			expectedErr:      ErrInsufficientPoints,
+		},
+		{
+			name:             "empty vertices",
+			vertices:         Vertices{},
+			counterClockwise: true,
+			expectedErr:      ErrInsufficientPoints,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hull, err := ConvexHull(tt.vertices, tt.counterClockwise)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, hull)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, *hull)
+			assert.True(t, hull.IsValid())
+			assert.Equal(t, tt.counterClockwise, hull.IsCounterClockwise())
+		})
+	}
+}
+
+func TestConvexHull_DoesNotModifyInput(t *testing.T) {
+	vertices := Vertices{{10, 10}, {0, 0}, {10, 0}, {0, 10}}
+	original := Vertices{{10, 10}, {0, 0}, {10, 0}, {0, 10}}
+
+	hull, err := ConvexHull(vertices, true)
+	require.NoError(t, err)
+	assert.Equal(t, original, vertices)
+
+	ring := *hull
+	ring[0][0] = 5
+	assert.Equal(t, original, vertices, "the hull holds copies of the vertices")
+	assert.NotEqual(t, ring[0], ring[len(ring)-1], "the closing position is a separate copy")
+}
+
+func TestConvexHullPolygon(t *testing.T) {
+	line := MustLineString(Vertices{{0, 0}, {4, 0}, {2, 1}, {4, 4}, {0, 4}})
+
+	hull, err := ConvexHullPolygon(line.Vertices())
+	require.NoError(t, err)
+	assert.Equal(t, MustPolygon(LinearRings{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}}), hull)
+
+	_, err = ConvexHullPolygon(Vertices{{0, 0}, {1, 1}, {2, 2}})
+	assert.ErrorIs(t, err, ErrInsufficientPoints)
+}
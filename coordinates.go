@@ -3,7 +3,9 @@ package geojson
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"slices"
+	"strconv"
 )
 
 const (
@@ -17,11 +19,18 @@ const (
 	LatitudeMax float64 = 90
 )
 
+const (
+	// EarthMeanRadius defines the mean radius of the WGS84 ellipsoid in meters,
+	// used for great-circle computations on a spherical approximation of the Earth.
+	EarthMeanRadius float64 = 6371008.8
+)
+
 const (
 	// Indices for accessing longitude, latitude, and altitude in the coordinates array.
 	idxCoordsLng = iota
 	idxCoordsLat
 	idxCoordsAlt
+	idxCoordsMeasure
 )
 
 const (
@@ -29,6 +38,8 @@ const (
 	coordsMinLen = 2
 	// coordsMaxLen is the maximum number of elements in a valid coordinates array.
 	coordsMaxLen = 3
+	// coordsMeasureLen is the number of elements in a coordinates array that carries a measure.
+	coordsMeasureLen = 4
 )
 
 var (
@@ -90,7 +101,7 @@ func (c *Coordinates) Latitude() float64 {
 
 // HasAltitude checks if the coordinates include an altitude value.
 func (c *Coordinates) HasAltitude() bool {
-	return len(*c) == coordsMaxLen
+	return len(*c) == coordsMaxLen || len(*c) == coordsMeasureLen
 }
 
 // Altitude returns the altitude value of the coordinates.
@@ -99,14 +110,96 @@ func (c *Coordinates) Altitude() float64 {
 	return (*c)[idxCoordsAlt]
 }
 
+// Measure returns the measure of the coordinates, the non-standard 4th value accepted when decoding
+// with UnmarshalOptions.AllowMeasure, and a boolean indicating whether one is present.
+func (c *Coordinates) Measure() (float64, bool) {
+	if len(*c) != coordsMeasureLen {
+		return 0, false
+	}
+
+	return (*c)[idxCoordsMeasure], true
+}
+
 // IsEqual checks if the current Coordinates are equal to the provided Coordinates.
 // It returns true if both have the same values in the same order, false otherwise.
 func (c *Coordinates) IsEqual(v Coordinates) bool {
 	return slices.Compare(*c, v) == 0
 }
 
+// Distance returns the great-circle distance in meters between the Coordinates and the other Coordinates,
+// computed with the haversine formula on a sphere of radius EarthMeanRadius. Altitude is ignored.
+func (c *Coordinates) Distance(other Coordinates) float64 {
+	lat1 := degreesToRadians(c.Latitude())
+	lat2 := degreesToRadians(other.Latitude())
+	dLat := lat2 - lat1
+	dLng := degreesToRadians(other.Longitude() - c.Longitude())
+
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLng/2), 2)
+
+	// Rounding errors can push h slightly outside [0, 1] for antipodal positions,
+	// which would make the square root or the arcsine return NaN.
+	h = math.Max(0, math.Min(1, h))
+
+	return 2 * EarthMeanRadius * math.Asin(math.Sqrt(h))
+}
+
+// Interpolate returns the position at the given fraction of the great-circle path from the Coordinates to
+// the other Coordinates, using spherical linear interpolation: 0 gives the Coordinates, 1 the other ones,
+// and values outside [0, 1] extend the path beyond them. Altitude is interpolated linearly when both
+// positions have it, and dropped otherwise. For antipodal positions, joined by infinitely many great
+// circles, longitude and latitude are interpolated linearly instead.
+func (c *Coordinates) Interpolate(other Coordinates, fraction float64) Coordinates {
+	out := interpolateLinear(*c, other, fraction)
+
+	d := c.Distance(other) / EarthMeanRadius
+	sinD := math.Sin(d)
+	if d == 0 || math.Abs(sinD) < 1e-12 {
+		return out
+	}
+
+	lat1, lng1 := degreesToRadians(c.Latitude()), degreesToRadians(c.Longitude())
+	lat2, lng2 := degreesToRadians(other.Latitude()), degreesToRadians(other.Longitude())
+
+	a := math.Sin((1-fraction)*d) / sinD
+	b := math.Sin(fraction*d) / sinD
+
+	x := a*math.Cos(lat1)*math.Cos(lng1) + b*math.Cos(lat2)*math.Cos(lng2)
+	y := a*math.Cos(lat1)*math.Sin(lng1) + b*math.Cos(lat2)*math.Sin(lng2)
+	z := a*math.Sin(lat1) + b*math.Sin(lat2)
+
+	out[idxCoordsLng] = radiansToDegrees(math.Atan2(y, x))
+	out[idxCoordsLat] = radiansToDegrees(math.Atan2(z, math.Hypot(x, y)))
+
+	return out
+}
+
+// Midpoint returns the position halfway along the great-circle path from the Coordinates to the other
+// Coordinates, as Interpolate does with a fraction of 0.5.
+func (c *Coordinates) Midpoint(other Coordinates) Coordinates {
+	return c.Interpolate(other, 0.5)
+}
+
+// BearingTo returns the initial great-circle bearing from the Coordinates to the other Coordinates, in degrees
+// clockwise from north in the range [0, 360). Together with Distance it gives the position of the other
+// Coordinates relative to these ones. The bearing of identical positions is undefined, and 0 is returned.
+// Altitude is ignored.
+func (c *Coordinates) BearingTo(other Coordinates) float64 {
+	lat1 := degreesToRadians(c.Latitude())
+	lat2 := degreesToRadians(other.Latitude())
+	dLng := degreesToRadians(other.Longitude() - c.Longitude())
+
+	y := math.Sin(dLng) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLng)
+
+	return math.Mod(radiansToDegrees(math.Atan2(y, x))+360, 360)
+}
+
 // String returns a string representation of the coordinates in GeoJSON format.
 func (c *Coordinates) String() string {
+	if m, ok := c.Measure(); ok {
+		return fmt.Sprintf("[ %g, %g, %g, %g ]", c.Longitude(), c.Latitude(), c.Altitude(), m)
+	}
+
 	if c.HasAltitude() {
 		return fmt.Sprintf("[ %g, %g, %g ]", c.Longitude(), c.Latitude(), c.Altitude())
 	}
@@ -146,38 +239,100 @@ func validateCoordinates(longitude, latitude float64) error {
 	return nil
 }
 
+// destination calculates the position reached by travelling distance meters from a position along the
+// great circle with the given initial bearing, in degrees clockwise from north, on a sphere of radius
+// EarthMeanRadius. The longitude is normalized to the range [-180, 180) and altitude is dropped.
+func destination(from Coordinates, bearing, distance float64) Coordinates {
+	lat1 := degreesToRadians(from[idxCoordsLat])
+	lng1 := degreesToRadians(from[idxCoordsLng])
+	theta := degreesToRadians(bearing)
+	delta := distance / EarthMeanRadius
+
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(delta) + math.Cos(lat1)*math.Sin(delta)*math.Cos(theta))
+	lng2 := lng1 + math.Atan2(
+		math.Sin(theta)*math.Sin(delta)*math.Cos(lat1),
+		math.Cos(delta)-math.Sin(lat1)*math.Sin(lat2),
+	)
+
+	lng := math.Mod(radiansToDegrees(lng2)+540, 360) - 180
+
+	return Coordinates{lng, radiansToDegrees(lat2)}
+}
+
+// interpolateLinear returns the position at fraction t along the straight segment from a to b.
+// Altitude is interpolated only when both positions have it.
+func interpolateLinear(a, b Coordinates, t float64) Coordinates {
+	c := Coordinates{
+		a[idxCoordsLng] + t*(b[idxCoordsLng]-a[idxCoordsLng]),
+		a[idxCoordsLat] + t*(b[idxCoordsLat]-a[idxCoordsLat]),
+	}
+	if a.HasAltitude() && b.HasAltitude() {
+		c = append(c, a.Altitude()+t*(b.Altitude()-a.Altitude()))
+	}
+
+	return c
+}
+
+// degreesToRadians converts an angle from degrees to radians.
+func degreesToRadians(v float64) float64 {
+	return v * math.Pi / 180
+}
+
+// radiansToDegrees converts an angle from radians to degrees.
+func radiansToDegrees(v float64) float64 {
+	return v * 180 / math.Pi
+}
+
 // buildCoordinates constructs a Coordinates object from a generic interface.
 // The input must be a slice of interface{} with 2 or 3 float64 elements,
-// representing the longitude, latitude, and optionally altitude.
+// representing the longitude, latitude, and optionally altitude, or 4 elements
+// when the options allow a measure. With Lenient, numbers encoded as strings are also accepted.
+// With NormalizeLongitude, longitudes from 180 to 360 are converted into the -180 to 180 range.
+// With RequireWithinBBox, positions outside the region are rejected with ErrCoordinateOutsideRegion.
 // Returns an error if the input is invalid or contains out-of-range values.
-func buildCoordinates(v interface{}) (*Coordinates, error) {
+func buildCoordinates(v interface{}, opts decodeOptions) (*Coordinates, error) {
 	rawSlice, ok := v.([]interface{})
 	if !ok {
 		return nil, ErrInvalidCoordinates
 	}
 
-	// Ensure the slice contains 2 or 3 elements.
-	if len(rawSlice) != coordsMinLen && len(rawSlice) != coordsMaxLen {
+	// Ensure the slice contains 2 or 3 elements, or 4 if a measure is allowed.
+	if !opts.acceptsPositionSize(len(rawSlice)) {
 		return nil, ErrCoordinatesSize
 	}
 
-	slice := make([]float64, len(rawSlice))
+	slice := make(Coordinates, len(rawSlice))
 	for i, s := range rawSlice {
 		switch c := s.(type) {
 		case float64:
 			slice[i] = c
 		case int:
 			slice[i] = float64(c)
+		case string:
+			// Numbers encoded as strings are accepted only when decoding leniently,
+			// and must be finite like the numbers of JSON.
+			n, err := strconv.ParseFloat(c, 64)
+			if !opts.lenient || err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+				return nil, ErrInvalidCoordinates
+			}
+			slice[i] = n
 		default:
 			return nil, ErrInvalidCoordinates
 		}
 	}
 
+	if opts.normalizeLongitude && slice[idxCoordsLng] > LongitudeMax && slice[idxCoordsLng] <= 2*LongitudeMax {
+		slice[idxCoordsLng] -= 2 * LongitudeMax
+	}
+
 	// Validate the longitude and latitude values.
-	coords, err := NewCoordinates(slice)
-	if err != nil {
-		return nil, err
+	if err := validateCoordinates(slice[idxCoordsLng], slice[idxCoordsLat]); err != nil {
+		return nil, fmt.Errorf("invalid coordinates: %w", err)
+	}
+
+	if opts.region != nil && !opts.region.contains(slice) {
+		return nil, fmt.Errorf("%w: %s", ErrCoordinateOutsideRegion, slice.String())
 	}
 
-	return coords, nil
+	return &slice, nil
 }
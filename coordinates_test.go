@@ -2,6 +2,7 @@ package geojson
 
 import (
 	"encoding/json"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -48,6 +49,7 @@ func TestCoordinates_HasAltitude(t *testing.T) {
 	}{
 		{"no altitude", Coordinates{12.34, 56.78}, false},
 		{"has altitude", Coordinates{12.34, 56.78, 100.0}, true},
+		{"has altitude and measure", Coordinates{12.34, 56.78, 100.0, 5}, true},
 	}
 
 	for _, tt := range tests {
@@ -73,6 +75,27 @@ func TestCoordinates_Altitude(t *testing.T) {
 	}
 }
 
+func TestCoordinates_Measure(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Coordinates
+		expected float64
+		ok       bool
+	}{
+		{"no altitude", Coordinates{12.34, 56.78}, 0, false},
+		{"altitude only", Coordinates{12.34, 56.78, 100.0}, 0, false},
+		{"measure", Coordinates{12.34, 56.78, 100.0, 5}, 5, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, ok := tt.input.Measure()
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, m)
+		})
+	}
+}
+
 func TestCoordinates_IsEqual(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -97,6 +120,92 @@ func TestCoordinates_IsEqual(t *testing.T) {
 	}
 }
 
+func TestCoordinates_Interpolate(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     Coordinates
+		to       Coordinates
+		fraction float64
+		expected Coordinates
+	}{
+		{"start", Coordinates{12.5, 41.9}, Coordinates{2.35, 48.86}, 0, Coordinates{12.5, 41.9}},
+		{"end", Coordinates{12.5, 41.9}, Coordinates{2.35, 48.86}, 1, Coordinates{2.35, 48.86}},
+		{"along the equator", Coordinates{0, 0}, Coordinates{90, 0}, 0.25, Coordinates{22.5, 0}},
+		{"along a meridian", Coordinates{10, -20}, Coordinates{10, 40}, 0.5, Coordinates{10, 10}},
+		{"beyond the end", Coordinates{0, 0}, Coordinates{90, 0}, 1.5, Coordinates{135, 0}},
+		{"with altitude", Coordinates{0, 0, 100}, Coordinates{90, 0, 200}, 0.5, Coordinates{45, 0, 150}},
+		{"altitude on one end", Coordinates{0, 0, 100}, Coordinates{90, 0}, 0.5, Coordinates{45, 0}},
+		{"same position", Coordinates{5, 5, 0}, Coordinates{5, 5, 10}, 0.5, Coordinates{5, 5, 5}},
+		{"antipodal", Coordinates{0, 0}, Coordinates{180, 0}, 0.5, Coordinates{90, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.from.Interpolate(tt.to, tt.fraction)
+			assert.InDeltaSlice(t, tt.expected, got, 1e-9)
+		})
+	}
+}
+
+func TestCoordinates_Midpoint(t *testing.T) {
+	from, to := Coordinates{-10, 60}, Coordinates{10, 60}
+	mid := from.Midpoint(to)
+
+	// The great circle bends towards the pole, away from the linear average.
+	assert.InDeltaSlice(t, Coordinates{0, 60.378348124804496}, mid, 1e-9)
+	assert.InDelta(t, from.Distance(mid), mid.Distance(to), 1e-6)
+}
+
+func TestCoordinates_Distance(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     Coordinates
+		to       Coordinates
+		expected float64
+		delta    float64
+	}{
+		{"identical coordinates", Coordinates{12.4924, 41.8902}, Coordinates{12.4924, 41.8902}, 0, 0},
+		{"altitude is ignored", Coordinates{12.4924, 41.8902, 100}, Coordinates{12.4924, 41.8902, 500}, 0, 0},
+		{"one degree of latitude", Coordinates{0, 0}, Coordinates{0, 1}, 111195.08, 0.01},
+		{"rome to paris", Coordinates{12.4964, 41.9028}, Coordinates{2.3522, 48.8566}, 1105760, 1000},
+		{"across the antimeridian", Coordinates{179.5, 0}, Coordinates{-179.5, 0}, 111195.08, 0.01},
+		{"antipodal points", Coordinates{0, 0}, Coordinates{180, 0}, math.Pi * EarthMeanRadius, 1e-6},
+		{"poles", Coordinates{0, 90}, Coordinates{0, -90}, math.Pi * EarthMeanRadius, 1e-6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := tt.from.Distance(tt.to)
+			assert.False(t, math.IsNaN(d))
+			assert.InDelta(t, tt.expected, d, tt.delta)
+			assert.InDelta(t, d, tt.to.Distance(tt.from), 1e-6, "distance should be symmetric")
+		})
+	}
+}
+
+func TestCoordinates_BearingTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     Coordinates
+		to       Coordinates
+		expected float64
+	}{
+		{"identical coordinates", Coordinates{12.4924, 41.8902}, Coordinates{12.4924, 41.8902}, 0},
+		{"north", Coordinates{0, 0}, Coordinates{0, 1}, 0},
+		{"east", Coordinates{0, 0}, Coordinates{1, 0}, 90},
+		{"south", Coordinates{0, 1}, Coordinates{0, 0}, 180},
+		{"west", Coordinates{0, 0}, Coordinates{-1, 0}, 270},
+		{"across the antimeridian", Coordinates{179.5, 0}, Coordinates{-179.5, 0}, 90},
+		{"rome to paris", Coordinates{12.4964, 41.9028}, Coordinates{2.3522, 48.8566}, 317.83093},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, tt.from.BearingTo(tt.to), 1e-5)
+		})
+	}
+}
+
 func TestCoordinates_String(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -241,7 +350,7 @@ func TestBuildCoordinates(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			coords, err := buildCoordinates(tt.input)
+			coords, err := buildCoordinates(tt.input, decodeOptions{})
 			if tt.expectErr {
 				require.Error(t, err)
 			} else {
@@ -0,0 +1,89 @@
+package geojson
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrCSVColumnIndex is returned when a CSV column index is negative or beyond the fields of a row.
+	ErrCSVColumnIndex = errors.New("CSV column index out of range")
+)
+
+// MultiPointFromCSV reads a CSV stream and builds a MultiPoint from the longitude and latitude
+// found in the given zero-based columns of every row. Every row is parsed, so a header row must be
+// skipped by the caller. Errors for malformed rows or invalid coordinates report the one-based row number.
+func MultiPointFromCSV(r io.Reader, lngCol, latCol int) (*MultiPoint, error) {
+	vertices, err := readCSVVertices(r, lngCol, latCol)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMultiPointFromVertices(vertices), nil
+}
+
+// LineStringFromCSV reads a CSV stream and builds a LineString from the longitude and latitude
+// found in the given zero-based columns of every row, in order. It behaves like MultiPointFromCSV,
+// and also returns ErrLineStringTooShort if fewer than 2 rows are read.
+func LineStringFromCSV(r io.Reader, lngCol, latCol int) (*LineString, error) {
+	vertices, err := readCSVVertices(r, lngCol, latCol)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLineString(vertices)
+}
+
+// readCSVVertices reads the positions stored in the longitude and latitude columns of every CSV row.
+func readCSVVertices(r io.Reader, lngCol, latCol int) (Vertices, error) {
+	if lngCol < 0 || latCol < 0 {
+		return nil, ErrCSVColumnIndex
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	var vertices Vertices
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV row %d: %w", row, err)
+		}
+
+		coords, err := parseCSVCoordinates(record, lngCol, latCol)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse CSV row %d: %w", row, err)
+		}
+
+		vertices = append(vertices, *coords)
+	}
+
+	return vertices, nil
+}
+
+// parseCSVCoordinates parses and validates the position stored in the given columns of a CSV record.
+func parseCSVCoordinates(record []string, lngCol, latCol int) (*Coordinates, error) {
+	if lngCol >= len(record) || latCol >= len(record) {
+		return nil, ErrCSVColumnIndex
+	}
+
+	lng, err := strconv.ParseFloat(strings.TrimSpace(record[lngCol]), 64)
+	if err != nil {
+		return nil, err
+	}
+
+	lat, err := strconv.ParseFloat(strings.TrimSpace(record[latCol]), 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCoordinates([]float64{lng, lat})
+}
@@ -0,0 +1,100 @@
+package geojson
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiPointFromCSV(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		lngCol      int
+		latCol      int
+		expected    Vertices
+		expectedErr error
+		errContains string
+	}{
+		{
+			name:     "lng and lat columns",
+			input:    "rome,12.4924,41.8902\nlondon,-0.1278, 51.5074\n",
+			lngCol:   1,
+			latCol:   2,
+			expected: Vertices{{12.4924, 41.8902}, {-0.1278, 51.5074}},
+		},
+		{
+			name:     "lat before lng",
+			input:    "41.8902,12.4924",
+			lngCol:   1,
+			latCol:   0,
+			expected: Vertices{{12.4924, 41.8902}},
+		},
+		{
+			name:     "empty input",
+			input:    "",
+			lngCol:   0,
+			latCol:   1,
+			expected: nil,
+		},
+		{
+			name:        "header row",
+			input:       "lng,lat\n12.4924,41.8902\n",
+			lngCol:      0,
+			latCol:      1,
+			expectedErr: strconv.ErrSyntax,
+			errContains: "row 1",
+		},
+		{
+			name:        "missing column",
+			input:       "12.4924,41.8902\n12.4924\n",
+			lngCol:      0,
+			latCol:      1,
+			expectedErr: ErrCSVColumnIndex,
+			errContains: "row 2",
+		},
+		{
+			name:        "latitude out of range",
+			input:       "12.4924,41.8902\n0,0\n12.4924,95\n",
+			lngCol:      0,
+			latCol:      1,
+			expectedErr: ErrLatitudeRange,
+			errContains: "row 3",
+		},
+		{
+			name:        "negative column",
+			input:       "12.4924,41.8902\n",
+			lngCol:      -1,
+			latCol:      1,
+			expectedErr: ErrCSVColumnIndex,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := MultiPointFromCSV(strings.NewReader(tt.input), tt.lngCol, tt.latCol)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.ErrorContains(t, err, tt.errContains)
+				assert.Nil(t, m)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, m.Vertices())
+		})
+	}
+}
+
+func TestLineStringFromCSV(t *testing.T) {
+	l, err := LineStringFromCSV(strings.NewReader("0,0\n1,1\n2,0\n"), 0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, Vertices{{0, 0}, {1, 1}, {2, 0}}, l.Vertices())
+
+	l, err = LineStringFromCSV(strings.NewReader("0,0\n"), 0, 1)
+	assert.ErrorIs(t, err, ErrLineStringTooShort)
+	assert.Nil(t, l)
+}
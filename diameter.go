@@ -0,0 +1,111 @@
+package geojson
+
+import "math"
+
+const (
+	// diameterBruteForceLimit is the number of vertices up to which the diameter is found
+	// by comparing every pair of vertices instead of building the convex hull.
+	diameterBruteForceLimit = 16
+)
+
+// diameter returns the two farthest-apart vertices and their great-circle distance in meters.
+// Small inputs, and inputs without a convex hull such as collinear vertices, compare every pair.
+// Larger inputs only compare the antipodal pairs of the convex hull found with the rotating calipers
+// technique, which treats longitude and latitude as planar coordinates to select the candidates.
+// It returns nil positions and 0 when there are no vertices.
+func diameter(v Vertices) (Coordinates, Coordinates, float64) {
+	if len(v) == 0 {
+		return nil, nil, 0
+	}
+
+	if len(v) > diameterBruteForceLimit {
+		if hull, err := ConvexHull(v, true); err == nil {
+			return caliperDiameter(Vertices(*hull)[:len(*hull)-1])
+		}
+	}
+
+	return bruteForceDiameter(v)
+}
+
+// bruteForceDiameter returns the two farthest-apart vertices by comparing every pair.
+func bruteForceDiameter(v Vertices) (Coordinates, Coordinates, float64) {
+	a, b, distance := v[0], v[0], 0.0
+	for i := 0; i < len(v); i++ {
+		for j := i + 1; j < len(v); j++ {
+			if d := v[i].Distance(v[j]); d > distance {
+				a, b, distance = v[i], v[j], d
+			}
+		}
+	}
+	return a, b, distance
+}
+
+// caliperDiameter returns the two farthest-apart vertices of an open, counterclockwise convex hull,
+// comparing the antipodal pairs visited by rotating a pair of parallel calipers around it.
+func caliperDiameter(hull Vertices) (Coordinates, Coordinates, float64) {
+	n := len(hull)
+	a, b, distance := hull[0], hull[0], 0.0
+
+	consider := func(p, q Coordinates) {
+		if d := p.Distance(q); d > distance {
+			a, b, distance = p, q, d
+		}
+	}
+
+	j := 1
+	for i := 0; i < n; i++ {
+		next := (i + 1) % n
+
+		// Advance the opposite caliper while it moves away from the current edge.
+		for math.Abs(cross(hull[i], hull[next], hull[(j+1)%n])) > math.Abs(cross(hull[i], hull[next], hull[j])) {
+			j = (j + 1) % n
+		}
+
+		consider(hull[i], hull[j])
+		consider(hull[next], hull[j])
+	}
+
+	return a, b, distance
+}
+
+// Diameter returns the Point's position twice and a distance of 0.
+func (p *Point) Diameter() (Coordinates, Coordinates, float64) {
+	return diameter(p.Vertices())
+}
+
+// Diameter returns the two farthest-apart vertices of the LineString and their great-circle distance in meters.
+func (l *LineString) Diameter() (Coordinates, Coordinates, float64) {
+	return diameter(l.vertices)
+}
+
+// Diameter returns the two farthest-apart positions of the MultiPoint and their great-circle distance in meters.
+func (m *MultiPoint) Diameter() (Coordinates, Coordinates, float64) {
+	return diameter(m.vertices)
+}
+
+// Diameter returns the two farthest-apart vertices of the MultiLineString and their great-circle distance
+// in meters.
+func (m *MultiLineString) Diameter() (Coordinates, Coordinates, float64) {
+	return diameter(m.Vertices())
+}
+
+// Diameter returns the two farthest-apart vertices of the Polygon and their great-circle distance in meters.
+// Since holes lie within the exterior ring, only the vertices of the exterior ring are considered.
+func (p *Polygon) Diameter() (Coordinates, Coordinates, float64) {
+	if len(p.rings) == 0 {
+		return nil, nil, 0
+	}
+	return diameter(Vertices(p.rings[0]))
+}
+
+// Diameter returns the two farthest-apart vertices of the MultiPolygon and their great-circle distance
+// in meters.
+func (m *MultiPolygon) Diameter() (Coordinates, Coordinates, float64) {
+	return diameter(m.Vertices())
+}
+
+// Diameter returns the two farthest-apart vertices of all the geometries of the GeometryCollection
+// and their great-circle distance in meters. It returns nil positions and 0 for an empty collection.
+func (g *GeometryCollection) Diameter() (Coordinates, Coordinates, float64) {
+	return diameter(g.Vertices())
+}
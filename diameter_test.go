@@ -0,0 +1,98 @@
+package geojson
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiameter(t *testing.T) {
+	// A grid of interior positions large enough to use the convex hull, with two clear extremes.
+	var grid Vertices
+	for i := 0; i < 6; i++ {
+		for j := 0; j < 6; j++ {
+			grid = append(grid, Coordinates{float64(i) * 0.2, float64(j) * 0.2})
+		}
+	}
+	grid = append(grid, Coordinates{-3, 0.5}, Coordinates{4, 0.5})
+
+	tests := []struct {
+		name     string
+		geometry interface {
+			Diameter() (Coordinates, Coordinates, float64)
+		}
+		expectedA Coordinates
+		expectedB Coordinates
+	}{
+		{
+			name:      "point",
+			geometry:  MustPoint([]float64{1, 2}),
+			expectedA: Coordinates{1, 2},
+			expectedB: Coordinates{1, 2},
+		},
+		{
+			name:      "line string",
+			geometry:  MustLineString(Vertices{{0, 0}, {1, 1}, {0.5, 0}}),
+			expectedA: Coordinates{0, 0},
+			expectedB: Coordinates{1, 1},
+		},
+		{
+			name:      "collinear multi point",
+			geometry:  NewMultiPointFromVertices(Vertices{{0, 0}, {0, 1}, {0, 2}, {0, 3}, {0, 4}, {0, 5}, {0, 6}, {0, 7}, {0, 8}, {0, 9}, {0, 10}, {0, 11}, {0, 12}, {0, 13}, {0, 14}, {0, 15}, {0, 16}, {0, 17}}),
+			expectedA: Coordinates{0, 0},
+			expectedB: Coordinates{0, 17},
+		},
+		{
+			name:      "convex hull",
+			geometry:  NewMultiPointFromVertices(grid),
+			expectedA: Coordinates{-3, 0.5},
+			expectedB: Coordinates{4, 0.5},
+		},
+		{
+			name: "polygon with hole",
+			geometry: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{0, 0}, {4, 0}, {4, 2}, {0, 2}, {0, 0}}),
+				*MustLinearRing(Vertices{{1, 1}, {1, 1.5}, {3, 1.5}, {1, 1}}),
+			}),
+			expectedA: Coordinates{0, 0},
+			expectedB: Coordinates{4, 2},
+		},
+		{
+			name: "geometry collection",
+			geometry: NewGeometryCollectionFromSlice([]Geometry{
+				MustPoint([]float64{-1, 0}),
+				MustLineString(Vertices{{0, 0}, {2, 0}}),
+			}),
+			expectedA: Coordinates{-1, 0},
+			expectedB: Coordinates{2, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b, distance := tt.geometry.Diameter()
+			assert.ElementsMatch(t, Vertices{tt.expectedA, tt.expectedB}, Vertices{a, b})
+			assert.InDelta(t, tt.expectedA.Distance(tt.expectedB), distance, 1e-6)
+		})
+	}
+
+	a, b, distance := NewGeometryCollectionFromSlice(nil).Diameter()
+	assert.Nil(t, a)
+	assert.Nil(t, b)
+	assert.Zero(t, distance)
+}
+
+func TestDiameter_MatchesBruteForce(t *testing.T) {
+	// Vertices of an irregular polygon around a center, compared with an exhaustive search.
+	var v Vertices
+	for i := 0; i < 40; i++ {
+		angle := 2 * math.Pi * float64(i) / 40
+		radius := 1 + 0.3*math.Sin(3*angle)
+		v = append(v, Coordinates{10 + radius*math.Cos(angle), 45 + 0.5*radius*math.Sin(angle)})
+	}
+
+	_, _, expected := bruteForceDiameter(v)
+	_, _, distance := diameter(v)
+	assert.InDelta(t, expected, distance, expected*1e-3)
+}
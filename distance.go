@@ -0,0 +1,95 @@
+package geojson
+
+import "math"
+
+// distanceToGeometry returns the great-circle distance in meters between the position and the closest
+// position of the geometry. It is 0 when the position lies inside a polygon or on its boundary, and +Inf
+// when the geometry has no positions. The closest position of each edge is found treating longitude
+// and latitude as planar coordinates, which is accurate for edges that are short relative to the Earth.
+func distanceToGeometry(g Geometry, c Coordinates) float64 {
+	switch g := g.(type) {
+	case *Point:
+		return distanceToVertices(Vertices{g.coords}, c)
+	case *MultiPoint:
+		return distanceToVertices(g.vertices, c)
+	case *LineString:
+		return distanceToEdges(verticesEdges(g.vertices), g.vertices, c)
+	case *MultiLineString:
+		distance := math.Inf(1)
+		for _, segment := range g.segments {
+			distance = math.Min(distance, distanceToEdges(verticesEdges(segment), segment, c))
+		}
+		return distance
+	case *Polygon:
+		return distanceToRings(g.rings, c)
+	case *MultiPolygon:
+		distance := math.Inf(1)
+		for _, rings := range g.rings {
+			distance = math.Min(distance, distanceToRings(rings, c))
+		}
+		return distance
+	case *GeometryCollection:
+		distance := math.Inf(1)
+		for _, geometry := range g.geometries {
+			distance = math.Min(distance, distanceToGeometry(geometry, c))
+		}
+		return distance
+	default:
+		return math.Inf(1)
+	}
+}
+
+// distanceToRings returns the distance in meters between the position and the polygon defined by the rings.
+func distanceToRings(rings LinearRings, c Coordinates) float64 {
+	if ringsContain(rings, c) {
+		return 0
+	}
+
+	var vertices Vertices
+	for _, ring := range rings {
+		vertices = append(vertices, ring...)
+	}
+
+	return distanceToEdges(ringsEdges(rings), vertices, c)
+}
+
+// distanceToEdges returns the distance in meters between the position and the closest of the edges.
+// The vertices are used instead when there are no edges, such as for a sequence of equal positions.
+func distanceToEdges(edges []edge, vertices Vertices, c Coordinates) float64 {
+	_, distance := closestOnEdges(edges, vertices, c)
+	return distance
+}
+
+// closestOnEdges returns the position of the edges closest to c, found treating longitude and latitude as
+// planar coordinates, and its distance in meters. The vertices are used instead when there are no edges.
+// It returns nil and +Inf when there are neither edges nor vertices.
+func closestOnEdges(edges []edge, vertices Vertices, c Coordinates) (Coordinates, float64) {
+	var closest Coordinates
+	distance := math.Inf(1)
+
+	if len(edges) == 0 {
+		for _, v := range vertices {
+			if d := c.Distance(v); d < distance {
+				closest, distance = v, d
+			}
+		}
+		return closest, distance
+	}
+
+	for _, e := range edges {
+		p := interpolateLinear(e.start, e.end, planarProjection(c, e.start, e.end))
+		if d := c.Distance(p); d < distance {
+			closest, distance = p, d
+		}
+	}
+	return closest, distance
+}
+
+// distanceToVertices returns the distance in meters between the position and the closest of the vertices.
+func distanceToVertices(vertices Vertices, c Coordinates) float64 {
+	distance := math.Inf(1)
+	for _, v := range vertices {
+		distance = math.Min(distance, c.Distance(v))
+	}
+	return distance
+}
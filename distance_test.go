@@ -0,0 +1,106 @@
+package geojson
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistanceToGeometry(t *testing.T) {
+	// One degree of latitude on the mean sphere.
+	degree := EarthMeanRadius * math.Pi / 180
+
+	square := MustPolygon(LinearRings{
+		*MustLinearRing(Vertices{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}),
+		*MustLinearRing(Vertices{{1, 1}, {1, 3}, {3, 3}, {3, 1}, {1, 1}}),
+	})
+
+	tests := []struct {
+		name     string
+		geometry Geometry
+		position Coordinates
+		expected float64
+	}{
+		{
+			name:     "point",
+			geometry: MustPoint([]float64{0, 1}),
+			position: Coordinates{0, 3},
+			expected: 2 * degree,
+		},
+		{
+			name:     "closest point of multi point",
+			geometry: NewMultiPointFromVertices(Vertices{{0, 10}, {0, 2}}),
+			position: Coordinates{0, 0},
+			expected: 2 * degree,
+		},
+		{
+			name:     "interior of line string edge",
+			geometry: MustLineString(Vertices{{-1, 0}, {1, 0}}),
+			position: Coordinates{0, 1},
+			expected: degree,
+		},
+		{
+			name:     "line string endpoint",
+			geometry: MustLineString(Vertices{{0, 0}, {0, 1}}),
+			position: Coordinates{0, 3},
+			expected: 2 * degree,
+		},
+		{
+			name:     "closest segment of multi line string",
+			geometry: MustMultiLineString(Segments{{{-1, 5}, {1, 5}}, {{-1, 1}, {1, 1}}}),
+			position: Coordinates{0, 0},
+			expected: degree,
+		},
+		{
+			name:     "inside polygon",
+			geometry: square,
+			position: Coordinates{0.5, 2},
+			expected: 0,
+		},
+		{
+			name:     "on polygon boundary",
+			geometry: square,
+			position: Coordinates{4, 2},
+			expected: 0,
+		},
+		{
+			name:     "inside polygon hole",
+			geometry: square,
+			position: Coordinates{2, 2.5},
+			expected: 0.5 * degree,
+		},
+		{
+			name:     "outside polygon",
+			geometry: square,
+			position: Coordinates{2, -1},
+			expected: degree,
+		},
+		{
+			name: "geometry collection",
+			geometry: NewGeometryCollectionFromSlice([]Geometry{
+				MustPoint([]float64{0, 5}),
+				NewGeometryCollectionFromSlice([]Geometry{MustPoint([]float64{0, -2})}),
+			}),
+			position: Coordinates{0, 0},
+			expected: 2 * degree,
+		},
+		{
+			name:     "empty geometry collection",
+			geometry: NewGeometryCollectionFromSlice(nil),
+			position: Coordinates{0, 0},
+			expected: math.Inf(1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			distance := distanceToGeometry(tt.geometry, tt.position)
+			if math.IsInf(tt.expected, 1) {
+				assert.True(t, math.IsInf(distance, 1))
+				return
+			}
+			assert.InDelta(t, tt.expected, distance, 1e-6)
+		})
+	}
+}
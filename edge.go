@@ -0,0 +1,114 @@
+package geojson
+
+import "math"
+
+// edge is a straight segment between two consecutive positions of a geometry.
+type edge struct {
+	start Coordinates
+	end   Coordinates
+}
+
+// isEqual reports whether the edge and the other edge join the same positions, in either direction.
+func (e edge) isEqual(other edge) bool {
+	return (e.start.IsEqual(other.start) && e.end.IsEqual(other.end)) ||
+		(e.start.IsEqual(other.end) && e.end.IsEqual(other.start))
+}
+
+// length returns the great-circle length of the edge in meters.
+func (e edge) length() float64 {
+	return e.start.Distance(e.end)
+}
+
+// intersects reports whether the edge and the other edge cross or touch, treating longitude and latitude
+// as planar coordinates.
+func (e edge) intersects(other edge) bool {
+	if e.crosses(other) {
+		return true
+	}
+
+	d1 := cross(other.start, other.end, e.start)
+	d2 := cross(other.start, other.end, e.end)
+	d3 := cross(e.start, e.end, other.start)
+	d4 := cross(e.start, e.end, other.end)
+
+	return (d1 == 0 && other.spans(e.start)) || (d2 == 0 && other.spans(e.end)) ||
+		(d3 == 0 && e.spans(other.start)) || (d4 == 0 && e.spans(other.end))
+}
+
+// crosses reports whether the edge and the other edge cross at a single position inside both of them,
+// each one having its ends strictly on opposite sides of the other, treating longitude and latitude
+// as planar coordinates. Edges that only touch or overlap do not cross.
+func (e edge) crosses(other edge) bool {
+	d1 := cross(other.start, other.end, e.start)
+	d2 := cross(other.start, other.end, e.end)
+	d3 := cross(e.start, e.end, other.start)
+	d4 := cross(e.start, e.end, other.end)
+
+	return ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0))
+}
+
+// overlaps reports whether the edge and the other edge, which join at a common position, share more than
+// that position, because one doubles back along the other.
+func (e edge) overlaps(other edge) bool {
+	// Find the positions at the far ends of the common one, which ends the edge unless the other edge
+	// precedes it, as the last edge of a ring precedes the first.
+	a, b := e.start, other.end
+	if !e.end.IsEqual(other.start) {
+		a, b = e.end, other.start
+	}
+
+	return (cross(e.start, e.end, b) == 0 && e.spans(b)) || (cross(other.start, other.end, a) == 0 && other.spans(a))
+}
+
+// spans reports whether the position c, known to be collinear with the edge, lies within its extent.
+func (e edge) spans(c Coordinates) bool {
+	return math.Min(e.start[idxCoordsLng], e.end[idxCoordsLng]) <= c[idxCoordsLng] &&
+		c[idxCoordsLng] <= math.Max(e.start[idxCoordsLng], e.end[idxCoordsLng]) &&
+		math.Min(e.start[idxCoordsLat], e.end[idxCoordsLat]) <= c[idxCoordsLat] &&
+		c[idxCoordsLat] <= math.Max(e.start[idxCoordsLat], e.end[idxCoordsLat])
+}
+
+// verticesEdges returns the edges joining consecutive positions of a sequence, skipping zero-length ones.
+func verticesEdges(v Vertices) []edge {
+	var edges []edge
+	for i := 0; i < len(v)-1; i++ {
+		if !v[i].IsEqual(v[i+1]) {
+			edges = append(edges, edge{start: v[i], end: v[i+1]})
+		}
+	}
+	return edges
+}
+
+// ringsEdges returns the edges of all the rings of a polygon.
+func ringsEdges(rings LinearRings) []edge {
+	var edges []edge
+	for _, ring := range rings {
+		edges = append(edges, verticesEdges(Vertices(ring))...)
+	}
+	return edges
+}
+
+// planarProjection returns the fraction along the segment from a to b of the position closest to c,
+// clamped to [0, 1], treating longitude and latitude as planar coordinates.
+func planarProjection(c, a, b Coordinates) float64 {
+	dx := b[idxCoordsLng] - a[idxCoordsLng]
+	dy := b[idxCoordsLat] - a[idxCoordsLat]
+
+	lengthSquared := dx*dx + dy*dy
+	if lengthSquared == 0 {
+		return 0
+	}
+
+	t := ((c[idxCoordsLng]-a[idxCoordsLng])*dx + (c[idxCoordsLat]-a[idxCoordsLat])*dy) / lengthSquared
+	return math.Max(0, math.Min(1, t))
+}
+
+// planarDistanceToSegment returns the distance, in degrees, from c to the closest position
+// of the segment from a to b, treating longitude and latitude as planar coordinates.
+func planarDistanceToSegment(c, a, b Coordinates) float64 {
+	t := planarProjection(c, a, b)
+	x := a[idxCoordsLng] + t*(b[idxCoordsLng]-a[idxCoordsLng])
+	y := a[idxCoordsLat] + t*(b[idxCoordsLat]-a[idxCoordsLat])
+
+	return math.Hypot(c[idxCoordsLng]-x, c[idxCoordsLat]-y)
+}
@@ -0,0 +1,87 @@
+package geojson
+
+import (
+	"slices"
+)
+
+// Explode splits the geometry into its single parts: a MultiPoint into Points, a MultiLineString into
+// LineStrings, and a MultiPolygon into Polygons, each holding a copy of the positions of its part.
+// A GeometryCollection is exploded recursively, concatenating the parts of its geometries in order.
+// Points, LineStrings, and Polygons are returned unchanged as the only part, so they are shared, not copied.
+// It returns nil for a nil geometry, and no parts for empty multi geometries and collections.
+func Explode(g Geometry) []Geometry {
+	switch v := g.(type) {
+	case nil:
+		return nil
+	case *MultiPoint:
+		parts := make([]Geometry, len(v.vertices))
+		for i, c := range v.vertices {
+			parts[i] = &Point{coords: slices.Clone(c)}
+		}
+		return parts
+	case *MultiLineString:
+		parts := make([]Geometry, len(v.segments))
+		for i := range v.segments {
+			parts[i], _ = v.LineStringAt(i)
+		}
+		return parts
+	case *MultiPolygon:
+		parts := make([]Geometry, len(v.rings))
+		for i := range v.rings {
+			parts[i], _ = v.PolygonAt(i)
+		}
+		return parts
+	case *GeometryCollection:
+		var parts []Geometry
+		for _, child := range v.geometries {
+			parts = append(parts, Explode(child)...)
+		}
+		return parts
+	default:
+		return []Geometry{g}
+	}
+}
+
+// Explode returns a new FeatureCollection with a feature for every part of the geometry of each feature,
+// as split by Explode, in order. Each new feature holds a copy of its part and of the properties, ID, and
+// foreign members of the feature it comes from, so parts of the same feature share its ID. Features whose
+// geometry has no parts, such as null geometries or empty MultiPolygons, are copied as they are. The other
+// members of the collection are copied as FeatureCollection.Clone does.
+func (f *FeatureCollection) Explode() *FeatureCollection {
+	if f == nil {
+		return nil
+	}
+
+	exploded := &FeatureCollection{
+		SerializeBBox:          f.SerializeBBox,
+		PreserveFeaturesMember: f.PreserveFeaturesMember,
+		ForeignMembers:         cloneForeignMembers(f.ForeignMembers),
+		featuresMember:         f.featuresMember,
+		bbox:                   slices.Clone(f.bbox),
+	}
+	if f.Features != nil {
+		exploded.Features = make([]Feature, 0, len(f.Features))
+	}
+
+	for i := range f.Features {
+		src := &f.Features[i]
+
+		parts := Explode(src.Geometry)
+		if len(parts) == 0 {
+			exploded.Features = append(exploded.Features, *src.Clone())
+			continue
+		}
+
+		for _, part := range parts {
+			exploded.Features = append(exploded.Features, Feature{
+				Geometry:       cloneGeometry(part),
+				Properties:     cloneProperties(src.Properties),
+				ID:             src.ID.clone(),
+				SerializeBBox:  src.SerializeBBox,
+				ForeignMembers: cloneForeignMembers(src.ForeignMembers),
+			})
+		}
+	}
+
+	return exploded
+}
@@ -0,0 +1,100 @@
+package geojson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplode(t *testing.T) {
+	square := LinearRings{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}
+	other := LinearRings{{{5, 5}, {6, 5}, {6, 6}, {5, 6}, {5, 5}}}
+	point := MustPoint([]float64{1, 2})
+
+	tests := []struct {
+		name string
+		g    Geometry
+		want []Geometry
+	}{
+		{"nil", nil, nil},
+		{"point", point, []Geometry{point}},
+		{
+			"multi point",
+			&MultiPoint{vertices: Vertices{{0, 0}, {1, 1, 5}}},
+			[]Geometry{&Point{coords: Coordinates{0, 0}}, &Point{coords: Coordinates{1, 1, 5}}},
+		},
+		{
+			"multi line string",
+			MustMultiLineString(Segments{{{0, 0}, {1, 1}}, {{2, 2}, {3, 3}}}),
+			[]Geometry{&LineString{vertices: Vertices{{0, 0}, {1, 1}}}, &LineString{vertices: Vertices{{2, 2}, {3, 3}}}},
+		},
+		{
+			"multi polygon",
+			MustMultiPolygonFromRingSlice([]LinearRings{square, other}),
+			[]Geometry{&Polygon{rings: square}, &Polygon{rings: other}},
+		},
+		{"empty multi polygon", NewMultiPolygon(), []Geometry{}},
+		{
+			"geometry collection",
+			&GeometryCollection{geometries: []Geometry{point, &MultiPoint{vertices: Vertices{{3, 4}, {5, 6}}}}},
+			[]Geometry{point, &Point{coords: Coordinates{3, 4}}, &Point{coords: Coordinates{5, 6}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Explode(tt.g)
+			require.Len(t, got, len(tt.want))
+			for i := range tt.want {
+				assert.True(t, GeometriesEqual(tt.want[i], got[i]), "part %d: got %v", i, got[i])
+			}
+		})
+	}
+
+	m := &MultiPoint{vertices: Vertices{{0, 0}}}
+	parts := Explode(m)
+	parts[0].(*Point).coords[0] = 9
+	assert.Equal(t, Coordinates{0, 0}, m.vertices[0], "parts should not alias the multi geometry")
+}
+
+func TestFeatureCollection_Explode(t *testing.T) {
+	fc := &FeatureCollection{
+		Features: []Feature{
+			{
+				Geometry:   MustMultiLineString(Segments{{{0, 0}, {1, 1}}, {{2, 2}, {3, 3}}}),
+				Properties: Properties{"name": "a"},
+				ID:         NewIntID(1),
+			},
+			{Properties: Properties{"name": "b"}},
+			{Geometry: MustPoint([]float64{4, 4}), Properties: Properties{"name": "c"}},
+		},
+	}
+
+	exploded := fc.Explode()
+	require.Len(t, exploded.Features, 4)
+
+	wantGeometries := []Geometry{
+		&LineString{vertices: Vertices{{0, 0}, {1, 1}}},
+		&LineString{vertices: Vertices{{2, 2}, {3, 3}}},
+		nil,
+		MustPoint([]float64{4, 4}),
+	}
+	wantNames := []string{"a", "a", "b", "c"}
+	for i, f := range exploded.Features {
+		assert.True(t, GeometriesEqual(wantGeometries[i], f.Geometry), "feature %d", i)
+		name, err := f.Properties.GetString("name")
+		require.NoError(t, err)
+		assert.Equal(t, wantNames[i], name)
+	}
+	assert.True(t, NewIntID(1).Equal(exploded.Features[1].ID))
+
+	require.NoError(t, exploded.Features[0].Properties.Set("name", "changed"))
+	exploded.Features[3].Geometry.(*Point).coords[0] = 9
+	assert.Equal(t, "a", fc.Features[0].Properties["name"], "properties should be copied")
+	assert.Equal(t, "b", exploded.Features[2].Properties["name"])
+	assert.Equal(t, 4.0, fc.Features[2].Geometry.(*Point).Longitude(), "geometries should be copied")
+	assert.Equal(t, "a", exploded.Features[1].Properties["name"], "parts should not share properties")
+
+	assert.Nil(t, (*FeatureCollection)(nil).Explode())
+}
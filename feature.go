@@ -6,11 +6,37 @@ import (
 )
 
 // Feature represents a GeoJSON feature with a geometry, properties, an optional ID, and bounding box toggling.
+//
+// Top-level members other than "type", "geometry", "properties", "id", and "bbox" are foreign members.
+// They are kept as raw JSON in ForeignMembers when decoding, and emitted in key order after the other
+// members when encoding. Entries of ForeignMembers named after a reserved member are ignored.
 type Feature struct {
-	Geometry      Geometry   // Geometry specifies the spatial information of the feature.
-	Properties    Properties // Properties contains supplementary data about the feature.
-	ID            *ID        // ID is an optional identifier for the feature.
-	SerializeBBox bool       // SerializeBBox determines whether to include the bounding box in the serialized JSON.
+	Geometry       Geometry                   // Geometry specifies the spatial information of the feature.
+	Properties     Properties                 // Properties contains supplementary data about the feature.
+	ID             *ID                        // ID is an optional identifier for the feature.
+	SerializeBBox  bool                       // SerializeBBox determines whether to include the bounding box in the serialized JSON.
+	ForeignMembers map[string]json.RawMessage // ForeignMembers holds the additional top-level members of the feature.
+	geometryAbsent bool                       // geometryAbsent records that the decoded GeoJSON had no geometry member.
+}
+
+// HasGeometryMember reports whether the "geometry" member was present when the Feature was decoded,
+// even with a null value, telling an explicit null apart from a missing member. Features that were
+// not decoded report true, since the member is always emitted when encoding.
+func (f *Feature) HasGeometryMember() bool {
+	return !f.geometryAbsent
+}
+
+// SetGeometry replaces the geometry of the feature; a nil geometry is encoded as null. Since the geometry
+// is now defined, HasGeometryMember reports true afterwards, even for a feature decoded without one.
+func (f *Feature) SetGeometry(g Geometry) {
+	f.Geometry = g
+	f.geometryAbsent = false
+}
+
+// SetProperty assigns a value to a property of the feature as Properties.Set does, creating the properties
+// if the feature has none. Returns an error if the key is empty.
+func (f *Feature) SetProperty(key string, value interface{}) error {
+	return f.Properties.Set(key, value)
 }
 
 // BoundingBox calculates and returns the bounding box for the feature's geometry.
@@ -36,10 +62,67 @@ func (f *Feature) GeometryObject() GeometryObject {
 	}
 }
 
+// Walk calls fn for every position of the feature's geometry in depth-first order,
+// and stops early as soon as fn returns false. Features without a geometry visit nothing.
+func (f *Feature) Walk(fn func(c Coordinates) bool) {
+	walkGeometry(f.Geometry, fn)
+}
+
+// Equal reports whether the Feature and the other Feature have the same geometry, properties, and ID.
+// Geometries are compared with GeometriesEqual, so two features without a geometry are equal, and properties
+// are compared deeply, so values must also have the same Go types: an int 1 set in code differs from the
+// float64 1 decoded from JSON. A nil Properties map equals an empty one. SerializeBBox and ForeignMembers
+// are not compared.
+func (f *Feature) Equal(other *Feature) bool {
+	if f == nil || other == nil {
+		return f == nil && other == nil
+	}
+
+	return GeometriesEqual(f.Geometry, other.Geometry) &&
+		equalProperties(f.Properties, other.Properties) &&
+		f.ID.Equal(other.ID)
+}
+
+// EstimatedJSONSize returns the approximate size in bytes of the GeoJSON representation of the Feature.
+// Geometry and bounding box are measured from their coordinates, while properties are estimated
+// from the values they hold.
+func (f *Feature) EstimatedJSONSize() int {
+	members := []int{
+		estimateMemberSize("type", estimateStringSize(string(TypeFeature))),
+		estimateMemberSize("geometry", estimateGeometryValueSize(f.Geometry)),
+	}
+
+	if len(f.Properties) > 0 {
+		members = append(members, estimateMemberSize("properties", estimateMapSize(f.Properties)))
+	} else {
+		members = append(members, estimateMemberSize("properties", jsonNullSize))
+	}
+
+	if f.ID != nil {
+		members = append(members, estimateMemberSize("id", f.ID.estimatedJSONSize()))
+	}
+
+	for _, key := range foreignMemberKeys(f.ForeignMembers, featureMembers) {
+		members = append(members, estimateMemberSize(key, len(f.ForeignMembers[key])))
+	}
+
+	size := estimateObjectSize(members...)
+	if f.SerializeBBox {
+		size += estimateBBoxMemberSize(f.BoundingBox())
+	}
+
+	return size
+}
+
 // UnmarshalJSON deserializes GeoJSON data into a Feature object.
 func (f *Feature) UnmarshalJSON(bytes []byte) error {
+	return f.decode(bytes, decodeOptions{})
+}
+
+// decode deserializes GeoJSON data into the Feature applying the decoding options to its geometry.
+func (f *Feature) decode(bytes []byte, opts decodeOptions) error {
 	few := &Object{}
-	if err := json.Unmarshal(bytes, few); err != nil {
+	if err := few.decode(bytes, opts); err != nil {
 		return fmt.Errorf("failed to unmarshal feature: %w", err)
 	}
 
@@ -50,16 +133,35 @@ func (f *Feature) UnmarshalJSON(bytes []byte) error {
 	f.Geometry = few.feature.Geometry
 	f.Properties = few.feature.Properties
 	f.ID = few.feature.ID
+	f.ForeignMembers = few.feature.ForeignMembers
+	f.geometryAbsent = few.feature.geometryAbsent
 
 	return nil
 }
 
 // MarshalJSON serializes a Feature object into GeoJSON format.
 func (f *Feature) MarshalJSON() ([]byte, error) {
+	return f.marshalJSON(f.Properties)
+}
+
+// MarshalJSONWithProperties serializes the Feature into GeoJSON format, emitting only the properties
+// whose keys are listed in include. Geometry and ID are serialized unchanged, and the Feature is not modified.
+func (f *Feature) MarshalJSONWithProperties(include []string) ([]byte, error) {
+	return f.marshalJSON(f.Properties.filter(include, true))
+}
+
+// MarshalJSONExcluding serializes the Feature into GeoJSON format, omitting the properties
+// whose keys are listed in exclude. Geometry and ID are serialized unchanged, and the Feature is not modified.
+func (f *Feature) MarshalJSONExcluding(exclude []string) ([]byte, error) {
+	return f.marshalJSON(f.Properties.filter(exclude, false))
+}
+
+// marshalJSON serializes the Feature into GeoJSON format with the given properties.
+func (f *Feature) marshalJSON(properties Properties) ([]byte, error) {
 	fj := &featureJSONOutput{
 		Type:       TypeFeature,
 		Geometry:   f.Geometry,
-		Properties: f.Properties,
+		Properties: properties,
 		ID:         f.ID,
 	}
 
@@ -67,7 +169,12 @@ func (f *Feature) MarshalJSON() ([]byte, error) {
 		fj.BBox = f.BoundingBox()
 	}
 
-	return json.Marshal(fj)
+	data, err := json.Marshal(fj)
+	if err != nil {
+		return nil, err
+	}
+
+	return appendForeignMembers(data, f.ForeignMembers, featureMembers)
 }
 
 // FeatureBuilder is a builder for constructing Feature objects.
@@ -3,12 +3,79 @@ package geojson
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"slices"
+)
+
+// FeaturesMember describes how the "features" member of a FeatureCollection appeared in decoded GeoJSON.
+type FeaturesMember int
+
+const (
+	// FeaturesMemberArray indicates that the features member was an array, possibly empty.
+	FeaturesMemberArray FeaturesMember = iota
+	// FeaturesMemberNull indicates that the features member was explicitly null.
+	FeaturesMemberNull
+	// FeaturesMemberAbsent indicates that the features member was missing.
+	FeaturesMemberAbsent
 )
 
 // FeatureCollection represents a GeoJSON object containing a collection of Features.
+//
+// By default, a missing or null "features" member is decoded as a nil Features slice and
+// serialized back as an empty array. Setting PreserveFeaturesMember makes MarshalJSON emit
+// the member as it was seen during decoding, as reported by FeaturesMember.
+//
+// Top-level members other than "type", "features", and "bbox" are foreign members, kept in ForeignMembers
+// like those of a Feature.
 type FeatureCollection struct {
-	Features      []Feature // Features contains the list of features in the collection.
-	SerializeBBox bool      // SerializeBBox determines whether to include the bounding box in the serialized JSON.
+	Features               []Feature                  // Features contains the list of features in the collection.
+	SerializeBBox          bool                       // SerializeBBox determines whether to include the bounding box in the serialized JSON.
+	PreserveFeaturesMember bool                       // PreserveFeaturesMember determines whether to emit a null or absent features member as decoded.
+	ForeignMembers         map[string]json.RawMessage // ForeignMembers holds the additional top-level members of the collection.
+	featuresMember         FeaturesMember             // featuresMember records how the features member appeared in the decoded input.
+	bbox                   BoundingBox                // bbox is the bounding box declared in the decoded GeoJSON, if any.
+}
+
+// FeaturesMember reports how the "features" member appeared when the FeatureCollection was decoded.
+// Collections that were not decoded report FeaturesMemberArray.
+func (f *FeatureCollection) FeaturesMember() FeaturesMember {
+	return f.featuresMember
+}
+
+// ParsedBBox returns the bounding box declared in the decoded GeoJSON of the FeatureCollection,
+// and a boolean indicating whether one was present.
+func (f *FeatureCollection) ParsedBBox() (BoundingBox, bool) {
+	return f.bbox, len(f.bbox) > 0
+}
+
+// ClipFeaturesToDeclaredBBox removes the features whose bounding box does not intersect the bounding box
+// declared in the decoded GeoJSON, and returns the number of features removed. Features are compared by
+// bounding box only, so a feature near a corner may be kept even if its geometry lies outside the declared
+// extent. A declared bounding box whose minimum longitude exceeds its maximum is taken to cross the
+// antimeridian, as RFC 7946 allows. Features without a geometry, or with an empty one, are kept. Nothing is
+// removed when no bounding box was declared.
+func (f *FeatureCollection) ClipFeaturesToDeclaredBBox() int {
+	if len(f.bbox) == 0 {
+		return 0
+	}
+
+	declared := splitAtAntimeridian(f.bbox)
+
+	n := len(f.Features)
+	f.Features = slices.DeleteFunc(f.Features, func(feature Feature) bool {
+		if feature.Geometry == nil {
+			return false
+		}
+
+		box := feature.BoundingBox()
+		if box.IsZero() {
+			return false
+		}
+
+		return !slices.ContainsFunc(declared, box.Intersects)
+	})
+
+	return n - len(f.Features)
 }
 
 // BoundingBox calculates and returns the bounding box for all features in the collection.
@@ -25,6 +92,148 @@ func (f *FeatureCollection) Vertices() Vertices {
 	return v
 }
 
+// Walk calls fn for every position of the features' geometries in depth-first order,
+// feature by feature, and stops early as soon as fn returns false.
+func (f *FeatureCollection) Walk(fn func(c Coordinates) bool) {
+	for i := range f.Features {
+		if !walkGeometry(f.Features[i].Geometry, fn) {
+			return
+		}
+	}
+}
+
+// EstimatedJSONSize returns the approximate size in bytes of the GeoJSON representation
+// of the FeatureCollection, including all of its features.
+func (f *FeatureCollection) EstimatedJSONSize() int {
+	size := 0
+	for i := range f.Features {
+		size += f.Features[i].EstimatedJSONSize()
+	}
+
+	members := []int{
+		estimateMemberSize("type", estimateStringSize(string(TypeFeatureCollection))),
+		estimateMemberSize("features", estimateArraySize(size, len(f.Features))),
+	}
+
+	for _, key := range foreignMemberKeys(f.ForeignMembers, featureCollectionMembers) {
+		members = append(members, estimateMemberSize(key, len(f.ForeignMembers[key])))
+	}
+
+	total := estimateObjectSize(members...)
+
+	if f.SerializeBBox {
+		total += estimateBBoxMemberSize(f.BoundingBox())
+	}
+
+	return total
+}
+
+// Diff compares the FeatureCollection with another one, matching features by ID.
+// Features whose ID is only in the receiver are reported as added, features whose ID is only in other
+// are reported as removed, and the receiver's version of features present in both but not Equal
+// are reported as changed. Features without an ID cannot be matched, so they are reported as added
+// or removed. IDs are expected to be unique within each collection.
+func (f *FeatureCollection) Diff(other *FeatureCollection) (added, removed, changed []Feature) {
+	previous := make(map[string]*Feature)
+	if other != nil {
+		for i := range other.Features {
+			if feature := &other.Features[i]; feature.ID != nil {
+				previous[feature.ID.key()] = feature
+			}
+		}
+	}
+
+	matched := make(map[string]bool)
+	for i := range f.Features {
+		feature := &f.Features[i]
+		if feature.ID == nil {
+			added = append(added, *feature)
+			continue
+		}
+
+		key := feature.ID.key()
+		match, ok := previous[key]
+		switch {
+		case !ok:
+			added = append(added, *feature)
+		case !feature.Equal(match):
+			changed = append(changed, *feature)
+		}
+		matched[key] = true
+	}
+
+	if other != nil {
+		for _, feature := range other.Features {
+			if feature.ID == nil || !matched[feature.ID.key()] {
+				removed = append(removed, feature)
+			}
+		}
+	}
+
+	return added, removed, changed
+}
+
+// NearestFeature returns the feature whose geometry is closest to the position, along with the great-circle
+// distance in meters between them. The distance is 0 when the position lies inside a polygon. Features without
+// a geometry are skipped. It returns nil and +Inf when the collection has no feature with a geometry.
+func (f *FeatureCollection) NearestFeature(c Coordinates) (*Feature, float64) {
+	var nearest *Feature
+	distance := math.Inf(1)
+
+	for i := range f.Features {
+		feature := &f.Features[i]
+		if feature.Geometry == nil {
+			continue
+		}
+
+		if d := distanceToGeometry(feature.Geometry, c); nearest == nil || d < distance {
+			nearest, distance = feature, d
+		}
+	}
+
+	return nearest, distance
+}
+
+// FilterByGeometryType returns the features whose geometry has the given type, in their original order.
+// Features without a geometry are skipped. The returned features share their geometry and properties with
+// those of the collection; use Feature.Clone to obtain independent copies.
+func (f *FeatureCollection) FilterByGeometryType(t GeometryType) []Feature {
+	var features []Feature
+	for _, feature := range f.Features {
+		if feature.Geometry != nil && feature.Geometry.Type() == t {
+			features = append(features, feature)
+		}
+	}
+	return features
+}
+
+// CountByType returns the number of features of the collection for each geometry type.
+// Features without a geometry are counted under TypeEmptyGeometry.
+func (f *FeatureCollection) CountByType() map[GeometryType]int {
+	counts := make(map[GeometryType]int)
+	for _, feature := range f.Features {
+		if feature.Geometry == nil {
+			counts[TypeEmptyGeometry]++
+			continue
+		}
+		counts[feature.Geometry.Type()]++
+	}
+	return counts
+}
+
+// ToGeometryCollection returns a new GeometryCollection holding the geometry of each feature as it is, in order,
+// discarding their properties and IDs, so GeometryCollection.ToFeatureCollection returns a feature for each
+// feature with a geometry. Features without a geometry are skipped. The geometries are shared, not copied.
+func (f *FeatureCollection) ToGeometryCollection() *GeometryCollection {
+	var geometries []Geometry
+	for _, feature := range f.Features {
+		if !isNilGeometry(feature.Geometry) {
+			geometries = append(geometries, feature.Geometry)
+		}
+	}
+	return NewGeometryCollectionFromSlice(geometries)
+}
+
 // MarshalJSON serializes the FeatureCollection into GeoJSON format.
 // If SerializeBBox is true, it includes the bounding box in the serialized JSON.
 func (f *FeatureCollection) MarshalJSON() ([]byte, error) {
@@ -35,30 +244,85 @@ func (f *FeatureCollection) MarshalJSON() ([]byte, error) {
 
 	fjc := featureCollectionJSONOutput{
 		Type:     TypeFeatureCollection,
-		Features: features,
+		Features: &features,
+	}
+
+	if f.PreserveFeaturesMember && len(f.Features) == 0 {
+		switch f.featuresMember {
+		case FeaturesMemberNull:
+			features = nil
+		case FeaturesMemberAbsent:
+			fjc.Features = nil
+		}
 	}
 
 	if f.SerializeBBox {
 		fjc.BBox = f.BoundingBox()
 	}
 
-	return json.Marshal(&fjc)
+	data, err := json.Marshal(&fjc)
+	if err != nil {
+		return nil, err
+	}
+
+	return appendForeignMembers(data, f.ForeignMembers, featureCollectionMembers)
 }
 
 // UnmarshalJSON deserializes GeoJSON data into a FeatureCollection object.
 // Returns an error if the input data cannot be unmarshaled.
 func (f *FeatureCollection) UnmarshalJSON(bytes []byte) error {
+	return f.decode(bytes, decodeOptions{})
+}
+
+// decode deserializes GeoJSON data into the FeatureCollection applying the decoding options to the
+// geometries of its features.
+func (f *FeatureCollection) decode(bytes []byte, opts decodeOptions) error {
 	few := &Object{}
-	err := json.Unmarshal(bytes, few)
-	if err != nil {
+	if err := few.decode(bytes, opts); err != nil {
 		return fmt.Errorf("failed to unmarshal feature collection: %w", err)
 	}
 
+	if few.features == nil {
+		return ErrInvalidFeature
+	}
+
 	*f = *few.features
 
 	return nil
 }
 
+// buildFeatureCollection creates a FeatureCollection from the raw "features" member and the declared
+// bounding box, recording whether the member was an array, null, or absent.
+func buildFeatureCollection(raw json.RawMessage, bbox BoundingBox, opts decodeOptions) (*FeatureCollection, error) {
+	if !bbox.IsValid() {
+		return nil, ErrInvalidBBox
+	}
+
+	fc := NewFeatureCollection()
+	fc.bbox = bbox
+
+	switch {
+	case raw == nil:
+		fc.featuresMember = FeaturesMemberAbsent
+	case string(raw) == "null":
+		fc.featuresMember = FeaturesMemberNull
+	default:
+		var features []json.RawMessage
+		if err := json.Unmarshal(raw, &features); err != nil {
+			return nil, err
+		}
+
+		fc.Features = make([]Feature, len(features))
+		for i, feature := range features {
+			if err := fc.Features[i].decode(feature, opts); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return fc, nil
+}
+
 // NewFeatureCollection creates and returns a new, empty FeatureCollection.
 func NewFeatureCollection() *FeatureCollection {
 	return &FeatureCollection{}
@@ -71,3 +335,34 @@ func NewFeatureCollectionFromFeatures(features []Feature) *FeatureCollection {
 		Features: features,
 	}
 }
+
+// MergeFeatureCollections returns a new FeatureCollection holding the features of all the given collections,
+// in order. Nil collections are skipped. The features are copied into a new slice, so adding, removing or
+// replacing features of a source afterwards does not affect the result, while their geometries and properties
+// are shared; use Clone on the result for a deep copy. SerializeBBox is set only if it is set on every
+// collection, and other members such as foreign members and the declared bounding box are not merged.
+func MergeFeatureCollections(fcs ...*FeatureCollection) *FeatureCollection {
+	merged := NewFeatureCollection()
+
+	n := 0
+	for _, fc := range fcs {
+		if fc != nil {
+			n += len(fc.Features)
+		}
+	}
+	merged.Features = make([]Feature, 0, n)
+
+	serializeBBox, found := true, false
+	for _, fc := range fcs {
+		if fc == nil {
+			continue
+		}
+
+		merged.Features = append(merged.Features, fc.Features...)
+		serializeBBox = serializeBBox && fc.SerializeBBox
+		found = true
+	}
+	merged.SerializeBBox = found && serializeBBox
+
+	return merged
+}
@@ -1,6 +1,8 @@
 package geojson
 
 import (
+	"encoding/json"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -98,7 +100,7 @@ func TestFeatureCollection_MarshalJSON(t *testing.T) {
 				},
 				SerializeBBox: true,
 			},
-			expectedJSON: `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]}}],"bbox":[1,2,1,2]}`,
+			expectedJSON: `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":null}],"bbox":[1,2,1,2]}`,
 			expectError:  false,
 		},
 		{
@@ -109,7 +111,7 @@ func TestFeatureCollection_MarshalJSON(t *testing.T) {
 				},
 				SerializeBBox: false,
 			},
-			expectedJSON: `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]}}]}`,
+			expectedJSON: `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":null}]}`,
 			expectError:  false,
 		},
 		{
@@ -149,6 +151,11 @@ func TestFeatureCollection_UnmarshalJSON(t *testing.T) {
 			input:       `{"invalid":"data"}`,
 			expectError: true,
 		},
+		{
+			name:        "feature",
+			input:       `{"type":"Feature","geometry":null,"properties":null}`,
+			expectError: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -177,3 +184,275 @@ func TestNewFeatureCollectionFromFeatures(t *testing.T) {
 	fc := NewFeatureCollectionFromFeatures(features)
 	assert.Equal(t, features, fc.Features, "features mismatch")
 }
+
+func TestMergeFeatureCollections(t *testing.T) {
+	a := NewFeatureCollectionFromFeatures([]Feature{{ID: NewStringID("a1")}, {ID: NewStringID("a2")}})
+	b := NewFeatureCollectionFromFeatures([]Feature{{ID: NewStringID("b1")}})
+	a.SerializeBBox = true
+
+	merged := MergeFeatureCollections(a, nil, b)
+	assert.Equal(t, []Feature{{ID: NewStringID("a1")}, {ID: NewStringID("a2")}, {ID: NewStringID("b1")}}, merged.Features)
+	assert.False(t, merged.SerializeBBox)
+
+	// The merged features do not alias the sources.
+	a.Features[0] = Feature{ID: NewStringID("replaced")}
+	assert.Equal(t, NewStringID("a1"), merged.Features[0].ID)
+
+	b.SerializeBBox = true
+	assert.True(t, MergeFeatureCollections(a, nil, b).SerializeBBox)
+
+	empty := MergeFeatureCollections()
+	assert.Empty(t, empty.Features)
+	assert.False(t, empty.SerializeBBox)
+	assert.False(t, MergeFeatureCollections(nil).SerializeBBox)
+}
+
+func TestFeatureCollection_FeaturesMember(t *testing.T) {
+	tests := []struct {
+		name             string
+		input            string
+		expectedMember   FeaturesMember
+		expectedDefault  string
+		expectedPreserve string
+	}{
+		{
+			name:             "features absent",
+			input:            `{"type":"FeatureCollection"}`,
+			expectedMember:   FeaturesMemberAbsent,
+			expectedDefault:  `{"type":"FeatureCollection","features":[]}`,
+			expectedPreserve: `{"type":"FeatureCollection"}`,
+		},
+		{
+			name:             "features null",
+			input:            `{"type":"FeatureCollection","features":null}`,
+			expectedMember:   FeaturesMemberNull,
+			expectedDefault:  `{"type":"FeatureCollection","features":[]}`,
+			expectedPreserve: `{"type":"FeatureCollection","features":null}`,
+		},
+		{
+			name:             "features empty array",
+			input:            `{"type":"FeatureCollection","features":[]}`,
+			expectedMember:   FeaturesMemberArray,
+			expectedDefault:  `{"type":"FeatureCollection","features":[]}`,
+			expectedPreserve: `{"type":"FeatureCollection","features":[]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fc FeatureCollection
+			require.NoError(t, json.Unmarshal([]byte(tt.input), &fc))
+			assert.Equal(t, tt.expectedMember, fc.FeaturesMember())
+			assert.Empty(t, fc.Features)
+
+			data, err := json.Marshal(&fc)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expectedDefault, string(data), "default output mismatch")
+
+			fc.PreserveFeaturesMember = true
+			data, err = json.Marshal(&fc)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expectedPreserve, string(data), "preserved output mismatch")
+		})
+	}
+}
+
+func TestFeatureCollection_FeaturesMember_Populated(t *testing.T) {
+	fc := NewFeatureCollectionFromFeatures([]Feature{{Geometry: MustPoint([]float64{1, 2})}})
+	fc.PreserveFeaturesMember = true
+	assert.Equal(t, FeaturesMemberArray, fc.FeaturesMember())
+
+	data, err := json.Marshal(fc)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":null}]}`, string(data))
+}
+
+func TestFeatureCollection_Diff(t *testing.T) {
+	feature := func(id *ID, name string) Feature {
+		return Feature{Geometry: MustPoint([]float64{1, 2}), Properties: Properties{"name": name}, ID: id}
+	}
+
+	current := NewFeatureCollectionFromFeatures([]Feature{
+		feature(NewStringID("kept"), "same"),
+		feature(NewStringID("changed"), "new"),
+		feature(NewNumericID(3), "new feature"),
+		feature(nil, "anonymous"),
+	})
+	previous := NewFeatureCollectionFromFeatures([]Feature{
+		feature(NewStringID("kept"), "same"),
+		feature(NewStringID("changed"), "old"),
+		feature(NewStringID("3"), "removed feature"),
+	})
+
+	added, removed, changed := current.Diff(previous)
+
+	assert.Equal(t, []Feature{feature(NewNumericID(3), "new feature"), feature(nil, "anonymous")}, added)
+	assert.Equal(t, []Feature{feature(NewStringID("3"), "removed feature")}, removed)
+	assert.Equal(t, []Feature{feature(NewStringID("changed"), "new")}, changed)
+
+	t.Run("nil other", func(t *testing.T) {
+		added, removed, changed := current.Diff(nil)
+		assert.Len(t, added, 4)
+		assert.Empty(t, removed)
+		assert.Empty(t, changed)
+	})
+}
+
+func TestFeatureCollection_NearestFeature(t *testing.T) {
+	collection := NewFeatureCollectionFromFeatures([]Feature{
+		{ID: NewStringID("none")},
+		{Geometry: MustPoint([]float64{10, 10}), ID: NewStringID("far")},
+		{Geometry: MustLineString(Vertices{{0, 1}, {2, 1}}), ID: NewStringID("near")},
+	})
+
+	nearest, distance := collection.NearestFeature(Coordinates{1, 0})
+	require.NotNil(t, nearest)
+	assert.Equal(t, NewStringID("near"), nearest.ID)
+	assert.InDelta(t, EarthMeanRadius*math.Pi/180, distance, 1e-6)
+
+	nearest, distance = NewFeatureCollection().NearestFeature(Coordinates{1, 0})
+	assert.Nil(t, nearest)
+	assert.True(t, math.IsInf(distance, 1))
+}
+
+func TestFeatureCollection_FilterByGeometryType(t *testing.T) {
+	square := MustPolygon(LinearRings{*MustLinearRing(Vertices{{0, 0}, {1, 0}, {1, 1}, {0, 0}})})
+	fc := NewFeatureCollectionFromFeatures([]Feature{
+		{Geometry: square, ID: NewStringID("a")},
+		{Geometry: MustPoint([]float64{1, 2})},
+		{ID: NewStringID("empty")},
+		{Geometry: square, ID: NewStringID("b")},
+	})
+
+	assert.Equal(t, []Feature{
+		{Geometry: square, ID: NewStringID("a")},
+		{Geometry: square, ID: NewStringID("b")},
+	}, fc.FilterByGeometryType(TypePolygon))
+	assert.Empty(t, fc.FilterByGeometryType(TypeLineString))
+	assert.Empty(t, fc.FilterByGeometryType(TypeEmptyGeometry))
+
+	assert.Equal(t, map[GeometryType]int{
+		TypePolygon:       2,
+		TypePoint:         1,
+		TypeEmptyGeometry: 1,
+	}, fc.CountByType())
+	assert.Empty(t, NewFeatureCollection().CountByType())
+}
+
+func TestFeatureCollection_ToGeometryCollection(t *testing.T) {
+	point := MustPoint([]float64{1, 2})
+	line := MustLineString(Vertices{{0, 0}, {1, 1}})
+	other := MustPoint([]float64{3, 4})
+	nested := NewGeometryCollectionFromSlice([]Geometry{line, NewGeometryCollectionFromSlice([]Geometry{other})})
+
+	fc := NewFeatureCollectionFromFeatures([]Feature{
+		{Geometry: point, Properties: Properties{"name": "a"}, ID: NewStringID("a")},
+		{ID: NewStringID("empty")},
+		{Geometry: nested},
+		{Geometry: (*GeometryCollection)(nil)},
+	})
+
+	gc := fc.ToGeometryCollection()
+	assert.Equal(t, []Geometry{point, nested}, gc.Geometries())
+	assert.Empty(t, NewFeatureCollection().ToGeometryCollection().Geometries())
+
+	back := gc.ToFeatureCollection()
+	assert.Equal(t, []Feature{{Geometry: point}, {Geometry: nested}}, back.Features)
+}
+
+func TestFeatureCollection_Walk(t *testing.T) {
+	collection := NewFeatureCollectionFromFeatures([]Feature{
+		{Geometry: MustLineString(Vertices{{0, 0}, {1, 1}})},
+		{},
+		{Geometry: NewMultiPointFromVertices(Vertices{{2, 2}, {3, 3}})},
+	})
+
+	var visited Vertices
+	collection.Walk(func(c Coordinates) bool {
+		visited = append(visited, c)
+		return true
+	})
+	assert.Equal(t, Vertices{{0, 0}, {1, 1}, {2, 2}, {3, 3}}, visited)
+
+	visited = nil
+	collection.Walk(func(c Coordinates) bool {
+		visited = append(visited, c)
+		return !c.IsEqual(Coordinates{2, 2})
+	})
+	assert.Equal(t, Vertices{{0, 0}, {1, 1}, {2, 2}}, visited)
+}
+
+func TestFeatureCollection_ClipFeaturesToDeclaredBBox(t *testing.T) {
+	data := `{"type":"FeatureCollection","bbox":[0,0,10,10],"features":[
+		{"type":"Feature","geometry":{"type":"Point","coordinates":[5,5]},"properties":null,"id":"inside"},
+		{"type":"Feature","geometry":{"type":"Point","coordinates":[20,20]},"properties":null,"id":"outside"},
+		{"type":"Feature","geometry":{"type":"LineString","coordinates":[[-5,5],[5,5]]},"properties":null,"id":"crossing"},
+		{"type":"Feature","geometry":null,"properties":null,"id":"empty"},
+		{"type":"Feature","geometry":{"type":"GeometryCollection","geometries":[]},"properties":null,"id":"empty collection"}
+	]}`
+
+	var collection FeatureCollection
+	require.NoError(t, json.Unmarshal([]byte(data), &collection))
+
+	bbox, ok := collection.ParsedBBox()
+	assert.True(t, ok)
+	assert.Equal(t, BoundingBox{0, 0, 10, 10}, bbox)
+
+	assert.Equal(t, 1, collection.ClipFeaturesToDeclaredBBox())
+	var ids []*ID
+	for _, feature := range collection.Features {
+		ids = append(ids, feature.ID)
+	}
+	assert.Equal(t, []*ID{NewStringID("inside"), NewStringID("crossing"), NewStringID("empty"), NewStringID("empty collection")}, ids)
+	assert.Equal(t, 0, collection.ClipFeaturesToDeclaredBBox())
+
+	antimeridian := `{"type":"FeatureCollection","bbox":[170,-10,-170,10],"features":[
+		{"type":"Feature","geometry":{"type":"Point","coordinates":[175,0]},"properties":null,"id":"east"},
+		{"type":"Feature","geometry":{"type":"Point","coordinates":[-175,0]},"properties":null,"id":"west"},
+		{"type":"Feature","geometry":{"type":"Point","coordinates":[0,0]},"properties":null,"id":"outside"}
+	]}`
+	var crossing FeatureCollection
+	require.NoError(t, json.Unmarshal([]byte(antimeridian), &crossing))
+	assert.Equal(t, 1, crossing.ClipFeaturesToDeclaredBBox())
+	require.Len(t, crossing.Features, 2)
+	assert.Equal(t, NewStringID("east"), crossing.Features[0].ID)
+	assert.Equal(t, NewStringID("west"), crossing.Features[1].ID)
+
+	undeclared := NewFeatureCollectionFromFeatures([]Feature{{Geometry: MustPoint([]float64{20, 20})}})
+	_, ok = undeclared.ParsedBBox()
+	assert.False(t, ok)
+	assert.Equal(t, 0, undeclared.ClipFeaturesToDeclaredBBox())
+	assert.Len(t, undeclared.Features, 1)
+
+	err := json.Unmarshal([]byte(`{"type":"FeatureCollection","bbox":[0,0,10],"features":[]}`), &collection)
+	assert.ErrorIs(t, err, ErrInvalidBBox)
+}
+
+func TestFeatureCollection_ForeignMembers(t *testing.T) {
+	data := `{"type":"FeatureCollection","name":"places","features":[],` +
+		`"metadata":{ "source": "survey", "year": 2024 },"bbox":[0,0,1,1]}`
+
+	var collection FeatureCollection
+	require.NoError(t, json.Unmarshal([]byte(data), &collection))
+	assert.Equal(t, map[string]json.RawMessage{
+		"name":     json.RawMessage(`"places"`),
+		"metadata": json.RawMessage(`{ "source": "survey", "year": 2024 }`),
+	}, collection.ForeignMembers)
+
+	bbox, ok := collection.ParsedBBox()
+	assert.True(t, ok)
+	assert.Equal(t, BoundingBox{0, 0, 1, 1}, bbox)
+
+	// Foreign members are written back unchanged, after the standard members and in key order.
+	encoded, err := collection.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `{"type":"FeatureCollection","features":[],`+
+		`"metadata":{ "source": "survey", "year": 2024 },"name":"places"}`, string(encoded))
+	assert.Equal(t, len(encoded), collection.EstimatedJSONSize())
+
+	collection.ForeignMembers["features"] = json.RawMessage(`null`)
+	encoded, err = collection.MarshalJSON()
+	require.NoError(t, err)
+	assert.Contains(t, string(encoded), `"features":[]`)
+	assert.NotContains(t, string(encoded), `"features":null`)
+}
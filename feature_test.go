@@ -1,6 +1,7 @@
 package geojson
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -117,7 +118,19 @@ func TestFeature_MarshalJSON(t *testing.T) {
 				Geometry:      MustPoint(Coordinates{1.0, 2.0}),
 				SerializeBBox: true,
 			},
-			expected:    `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"bbox":[1,2,1,2]}`,
+			expected:    `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":null,"bbox":[1,2,1,2]}`,
+			expectError: false,
+		},
+		{
+			name:        "nil properties are emitted as null",
+			feature:     Feature{Geometry: MustPoint(Coordinates{1.0, 2.0})},
+			expected:    `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":null}`,
+			expectError: false,
+		},
+		{
+			name:        "empty properties are emitted as null",
+			feature:     Feature{Properties: Properties{}},
+			expected:    `{"type":"Feature","geometry":null,"properties":null}`,
 			expectError: false,
 		},
 	}
@@ -204,6 +217,29 @@ func TestFeature_UnmarshalJSON(t *testing.T) {
 	}
 }
 
+func TestFeature_SetGeometry(t *testing.T) {
+	var f Feature
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"Feature","properties":null}`), &f))
+	assert.False(t, f.HasGeometryMember())
+
+	point := MustPoint([]float64{1, 2})
+	f.SetGeometry(point)
+	assert.Same(t, point, f.Geometry)
+	assert.True(t, f.HasGeometryMember())
+
+	f.SetGeometry(nil)
+	assert.Nil(t, f.Geometry)
+}
+
+func TestFeature_SetProperty(t *testing.T) {
+	var f Feature
+	require.NoError(t, f.SetProperty("name", "a"))
+	require.NoError(t, f.SetProperty("count", 2))
+	assert.Equal(t, Properties{"name": "a", "count": 2}, f.Properties)
+
+	assert.ErrorIs(t, f.SetProperty("", 1), ErrKeyEmpty)
+}
+
 func TestFeatureBuilder(t *testing.T) {
 	t.Run("Build empty feature", func(t *testing.T) {
 		builder := NewFeatureBuilder()
@@ -275,3 +311,180 @@ func TestFeature_GeometryObject(t *testing.T) {
 		})
 	}
 }
+
+func TestFeature_Equal(t *testing.T) {
+	base := Feature{
+		Geometry:   MustPoint([]float64{1, 2}),
+		Properties: Properties{"name": "a"},
+		ID:         NewStringID("1"),
+	}
+
+	tests := []struct {
+		name     string
+		other    Feature
+		expected bool
+	}{
+		{
+			name:     "equal",
+			other:    Feature{Geometry: MustPoint([]float64{1, 2}), Properties: Properties{"name": "a"}, ID: NewStringID("1"), SerializeBBox: true},
+			expected: true,
+		},
+		{
+			name:     "different geometry",
+			other:    Feature{Geometry: MustPoint([]float64{1, 3}), Properties: Properties{"name": "a"}, ID: NewStringID("1")},
+			expected: false,
+		},
+		{
+			name:     "different properties",
+			other:    Feature{Geometry: MustPoint([]float64{1, 2}), Properties: Properties{"name": "b"}, ID: NewStringID("1")},
+			expected: false,
+		},
+		{
+			name:     "different id",
+			other:    Feature{Geometry: MustPoint([]float64{1, 2}), Properties: Properties{"name": "a"}, ID: NewNumericID(1)},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, base.Equal(&tt.other))
+		})
+	}
+
+	t.Run("nil and empty properties", func(t *testing.T) {
+		a := Feature{Properties: Properties{}}
+		b := Feature{}
+		assert.True(t, a.Equal(&b))
+	})
+
+	t.Run("property value types", func(t *testing.T) {
+		var decoded Feature
+		require.NoError(t, json.Unmarshal([]byte(`{"type":"Feature","geometry":null,"properties":{"n":1}}`), &decoded))
+
+		assert.False(t, decoded.Equal(&Feature{Properties: Properties{"n": 1}}))
+		assert.True(t, decoded.Equal(&Feature{Properties: Properties{"n": 1.0}}))
+	})
+}
+
+func TestFeature_MarshalJSONWithProperties(t *testing.T) {
+	feature := Feature{
+		Geometry:   MustPoint(Coordinates{1.0, 2.0}),
+		Properties: Properties{"name": "test", "owner": "internal", "secret": "s3cr3t"},
+		ID:         NewStringID("a"),
+	}
+
+	tests := []struct {
+		name     string
+		marshal  func() ([]byte, error)
+		expected string
+	}{
+		{
+			name:     "include",
+			marshal:  func() ([]byte, error) { return feature.MarshalJSONWithProperties([]string{"name", "missing"}) },
+			expected: `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":{"name":"test"},"id":"a"}`,
+		},
+		{
+			name:     "exclude",
+			marshal:  func() ([]byte, error) { return feature.MarshalJSONExcluding([]string{"owner", "secret"}) },
+			expected: `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":{"name":"test"},"id":"a"}`,
+		},
+		{
+			name:     "exclude nothing",
+			marshal:  func() ([]byte, error) { return feature.MarshalJSONExcluding(nil) },
+			expected: `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":{"name":"test","owner":"internal","secret":"s3cr3t"},"id":"a"}`,
+		},
+		{
+			name:     "include nothing",
+			marshal:  func() ([]byte, error) { return feature.MarshalJSONWithProperties(nil) },
+			expected: `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":null,"id":"a"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.marshal()
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(result))
+			assert.Len(t, feature.Properties, 3)
+		})
+	}
+}
+
+func TestFeature_HasGeometryMember(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected bool
+	}{
+		{"geometry", `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":null}`, true},
+		{"null geometry", `{"type":"Feature","geometry":null,"properties":null}`, true},
+		{"missing geometry", `{"type":"Feature","properties":null}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var f Feature
+			require.NoError(t, json.Unmarshal([]byte(tt.data), &f))
+			assert.Equal(t, tt.expected, f.HasGeometryMember())
+			assert.Equal(t, tt.expected, f.Clone().HasGeometryMember())
+		})
+	}
+
+	var fc FeatureCollection
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"FeatureCollection","features":[`+tests[1].data+`,`+tests[2].data+`]}`), &fc))
+	assert.True(t, fc.Features[0].HasGeometryMember())
+	assert.False(t, fc.Features[1].HasGeometryMember())
+
+	assert.True(t, (&Feature{}).HasGeometryMember())
+}
+
+func TestFeature_Walk(t *testing.T) {
+	feature := Feature{Geometry: MustPoint([]float64{12.4924, 41.8902})}
+
+	var visited Vertices
+	feature.Walk(func(c Coordinates) bool {
+		visited = append(visited, c)
+		return true
+	})
+	assert.Equal(t, Vertices{{12.4924, 41.8902}}, visited)
+
+	visited = nil
+	(&Feature{}).Walk(func(c Coordinates) bool {
+		visited = append(visited, c)
+		return true
+	})
+	assert.Nil(t, visited)
+}
+
+func TestFeature_ForeignMembers(t *testing.T) {
+	data := `{"type":"Feature","title":"Rome","geometry":{"type":"Point","coordinates":[12.4924,41.8902]},` +
+		`"properties":{"name":"Colosseum"},"crs":{"type":"name"},"id":1}`
+
+	var feature Feature
+	require.NoError(t, json.Unmarshal([]byte(data), &feature))
+	assert.Equal(t, map[string]json.RawMessage{
+		"title": json.RawMessage(`"Rome"`),
+		"crs":   json.RawMessage(`{"type":"name"}`),
+	}, feature.ForeignMembers)
+
+	encoded, err := json.Marshal(&feature)
+	require.NoError(t, err)
+	assert.Equal(t, `{"type":"Feature","geometry":{"type":"Point","coordinates":[12.4924,41.8902]},`+
+		`"properties":{"name":"Colosseum"},"id":1,"crs":{"type":"name"},"title":"Rome"}`, string(encoded))
+	assert.Equal(t, len(encoded), feature.EstimatedJSONSize())
+
+	// Foreign members never replace the members defined by RFC 7946.
+	feature.ForeignMembers["type"] = json.RawMessage(`"Point"`)
+	feature.ForeignMembers["id"] = json.RawMessage(`2`)
+	encoded, err = json.Marshal(&feature)
+	require.NoError(t, err)
+	assert.JSONEq(t, data, string(encoded))
+
+	feature.ForeignMembers = map[string]json.RawMessage{"broken": json.RawMessage(`{`)}
+	_, err = json.Marshal(&feature)
+	assert.Error(t, err)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"Feature","geometry":null,"properties":null}`), &feature))
+	assert.Nil(t, feature.ForeignMembers)
+}
@@ -0,0 +1,83 @@
+package geojson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+var (
+	// featureMembers lists the names of the members defined by RFC 7946 for a Feature.
+	featureMembers = []string{"type", "geometry", "properties", "id", "bbox"}
+
+	// featureCollectionMembers lists the names of the members defined by RFC 7946 for a FeatureCollection.
+	featureCollectionMembers = []string{"type", "features", "bbox"}
+)
+
+// decodeForeignMembers returns the top-level members of the JSON object whose names are not listed
+// in reserved, or nil if there are none.
+func decodeForeignMembers(data []byte, reserved []string) (map[string]json.RawMessage, error) {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, err
+	}
+
+	var foreign map[string]json.RawMessage
+	for key, value := range members {
+		if slices.Contains(reserved, key) {
+			continue
+		}
+		if foreign == nil {
+			foreign = make(map[string]json.RawMessage)
+		}
+		foreign[key] = value
+	}
+
+	return foreign, nil
+}
+
+// foreignMemberKeys returns the sorted names of the foreign members, skipping those listed in reserved.
+func foreignMemberKeys(members map[string]json.RawMessage, reserved []string) []string {
+	keys := make([]string, 0, len(members))
+	for key := range members {
+		if !slices.Contains(reserved, key) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+// appendForeignMembers adds the foreign members, sorted by name, to the end of an encoded, non-empty JSON object.
+// Members listed in reserved are skipped. Values are written as they are, after checking that they are
+// valid JSON, so that decoded members round-trip unchanged; a nil value is written as null.
+func appendForeignMembers(data []byte, members map[string]json.RawMessage, reserved []string) ([]byte, error) {
+	keys := foreignMemberKeys(members, reserved)
+	if len(keys) == 0 {
+		return data, nil
+	}
+
+	// Replace the closing brace of the object with the foreign members.
+	buf := bytes.NewBuffer(data[:len(data)-1])
+	for _, key := range keys {
+		value := members[key]
+		if value == nil {
+			value = json.RawMessage("null")
+		}
+		if !json.Valid(value) {
+			return nil, fmt.Errorf("invalid JSON in foreign member %q", key)
+		}
+
+		buf.WriteByte(',')
+		if err := writeJSONString(buf, key); err != nil {
+			return nil, err
+		}
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
@@ -1,5 +1,11 @@
 package geojson
 
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
 // GeometryType defines the type of geometry in GeoJSON.
 type GeometryType string
 
@@ -15,6 +21,24 @@ const (
 	TypeGeometryCollection GeometryType = "GeometryCollection"
 )
 
+const (
+	// PointSize defines the number of positions of a Point.
+	PointSize = 1
+)
+
+var (
+	// ErrVertexCount is returned when a geometry does not have enough positions for its type.
+	ErrVertexCount = errors.New("not enough positions for the geometry type")
+
+	// ErrNotSingleElement is returned when a multi geometry does not hold exactly one element
+	// and cannot be converted to its singular form.
+	ErrNotSingleElement = errors.New("multi geometry must hold exactly one element")
+
+	// ErrGeometryIndex is returned when a geometry index is outside the geometries of a GeometryCollection,
+	// or outside the line strings or polygons of a multi geometry.
+	ErrGeometryIndex = errors.New("geometry index out of range")
+)
+
 // GeometryIdentifier is an interface for objects that can report their geometry type.
 type GeometryIdentifier interface {
 	// Type returns the GeometryType of the object.
@@ -23,14 +47,261 @@ type GeometryIdentifier interface {
 
 // geometryBuilder is an interface for building coordinates for geometries.
 type geometryBuilder interface {
-	// buildCoordinates initializes a geometry's coordinates from the given input.
-	buildCoordinates(interface{}) error
+	// buildCoordinates initializes a geometry's coordinates from the given input, decoded with the options.
+	buildCoordinates(interface{}, decodeOptions) error
 }
 
 // Geometry is a composite interface that combines GeometryIdentifier, BoundingBoxer,
-// and geometryBuilder, representing a GeoJSON geometry object.
+// JSONSizer and geometryBuilder, representing a GeoJSON geometry object.
 type Geometry interface {
 	GeometryIdentifier
 	BoundingBoxer
+	JSONSizer
 	geometryBuilder
 }
+
+// MinimumVertices returns the minimum number of positions required for a valid geometry of the given type:
+// PointSize for a Point, LineStringMinimumSize for a LineString or MultiLineString, which needs at least
+// one line, and LinearRingMinimumSize for a Polygon. MultiPoint, MultiPolygon and GeometryCollection may be
+// empty, and unknown types also return 0.
+func MinimumVertices(t GeometryType) int {
+	switch t {
+	case TypePoint:
+		return PointSize
+	case TypeLineString, TypeMultiLineString:
+		return LineStringMinimumSize
+	case TypePolygon:
+		return LinearRingMinimumSize
+	default:
+		return 0
+	}
+}
+
+// ValidateVertexCount checks that n positions are enough for a valid geometry of the given type,
+// as reported by MinimumVertices. It returns an error wrapping ErrVertexCount if they are not,
+// or ErrInvalidTypeField if the type is unknown.
+func ValidateVertexCount(t GeometryType, n int) error {
+	switch t {
+	case TypePoint, TypeLineString, TypeMultiPoint, TypeMultiLineString,
+		TypePolygon, TypeMultiPolygon, TypeGeometryCollection:
+	default:
+		return ErrInvalidTypeField
+	}
+
+	if minimum := MinimumVertices(t); n < minimum {
+		return fmt.Errorf("%w: %s requires at least %d, got %d", ErrVertexCount, t, minimum, n)
+	}
+
+	return nil
+}
+
+// isNilGeometry reports whether the geometry is nil or a nil pointer to one of the geometry types.
+func isNilGeometry(g Geometry) bool {
+	switch v := g.(type) {
+	case nil:
+		return true
+	case *Point:
+		return v == nil
+	case *LineString:
+		return v == nil
+	case *MultiPoint:
+		return v == nil
+	case *MultiLineString:
+		return v == nil
+	case *Polygon:
+		return v == nil
+	case *MultiPolygon:
+		return v == nil
+	case *GeometryCollection:
+		return v == nil
+	default:
+		return false
+	}
+}
+
+// mapGeometry returns a copy of the geometry in which every sequence of positions
+// (the position of a Point, the vertices of a LineString or MultiPoint, each segment
+// of a MultiLineString and each ring of a Polygon or MultiPolygon) is replaced by the result of fn.
+// GeometryCollections are traversed recursively. The result is not validated.
+func mapGeometry(g Geometry, fn func(Vertices) Vertices) Geometry {
+	switch v := g.(type) {
+	case *Point:
+		return &Point{coords: fn(Vertices{v.coords})[0], SerializeBBox: v.SerializeBBox}
+	case *LineString:
+		return &LineString{vertices: fn(v.vertices), SerializeBBox: v.SerializeBBox}
+	case *MultiPoint:
+		return &MultiPoint{vertices: fn(v.vertices), SerializeBBox: v.SerializeBBox}
+	case *MultiLineString:
+		segments := make(Segments, len(v.segments))
+		for i, s := range v.segments {
+			segments[i] = fn(s)
+		}
+		return &MultiLineString{segments: segments, SerializeBBox: v.SerializeBBox}
+	case *Polygon:
+		return &Polygon{rings: mapLinearRings(v.rings, fn), SerializeBBox: v.SerializeBBox}
+	case *MultiPolygon:
+		slice := make([]LinearRings, len(v.rings))
+		for i, rings := range v.rings {
+			slice[i] = mapLinearRings(rings, fn)
+		}
+		return &MultiPolygon{rings: slice, SerializeBBox: v.SerializeBBox}
+	case *GeometryCollection:
+		geometries := make([]Geometry, len(v.geometries))
+		for i, child := range v.geometries {
+			geometries[i] = mapGeometry(child, fn)
+		}
+		return &GeometryCollection{geometries: geometries, SerializeBBox: v.SerializeBBox}
+	default:
+		return g
+	}
+}
+
+// walkGeometry calls fn for each position of the geometry in depth-first order, recursing into the children
+// of geometry collections. It stops as soon as fn returns false, and reports whether the walk completed.
+func walkGeometry(g Geometry, fn func(c Coordinates) bool) bool {
+	switch v := g.(type) {
+	case *Point:
+		return fn(v.coords)
+	case *LineString:
+		return walkVertices(v.vertices, fn)
+	case *MultiPoint:
+		return walkVertices(v.vertices, fn)
+	case *MultiLineString:
+		for _, s := range v.segments {
+			if !walkVertices(s, fn) {
+				return false
+			}
+		}
+	case *Polygon:
+		return walkLinearRings(v.rings, fn)
+	case *MultiPolygon:
+		for _, rings := range v.rings {
+			if !walkLinearRings(rings, fn) {
+				return false
+			}
+		}
+	case *GeometryCollection:
+		for _, child := range v.geometries {
+			if !walkGeometry(child, fn) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// replaceCoordinates replaces the positions of the geometry in place with the given ones, taken in the order
+// visited by walkGeometry, and restores the orientation of polygon rings. It returns the unused positions.
+func replaceCoordinates(g Geometry, v Vertices) Vertices {
+	switch g := g.(type) {
+	case *Point:
+		g.coords, v = v[0], v[1:]
+	case *LineString:
+		v = v[copy(g.vertices, v):]
+	case *MultiPoint:
+		v = v[copy(g.vertices, v):]
+	case *MultiLineString:
+		for _, s := range g.segments {
+			v = v[copy(s, v):]
+		}
+	case *Polygon:
+		v = replaceLinearRings(g.rings, v)
+		g.InvalidateBBox()
+	case *MultiPolygon:
+		for _, rings := range g.rings {
+			v = replaceLinearRings(rings, v)
+		}
+		g.InvalidateBBox()
+	case *GeometryCollection:
+		for _, child := range g.geometries {
+			v = replaceCoordinates(child, v)
+		}
+	}
+	return v
+}
+
+// replaceLinearRings replaces the positions of the rings in place with the given ones, restores
+// the orientation of the rings of the polygon, and returns the unused positions.
+func replaceLinearRings(rings LinearRings, v Vertices) Vertices {
+	for _, ring := range rings {
+		v = v[copy(ring, v):]
+	}
+	ensureOrientation(rings)
+	return v
+}
+
+// walkLinearRings calls fn for each position of the rings, stopping as soon as fn returns false.
+func walkLinearRings(rings LinearRings, fn func(c Coordinates) bool) bool {
+	for _, ring := range rings {
+		if !walkVertices(Vertices(ring), fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// walkVertices calls fn for each of the vertices, stopping as soon as fn returns false.
+func walkVertices(v Vertices, fn func(c Coordinates) bool) bool {
+	for _, c := range v {
+		if !fn(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// mapLinearRings returns a new LinearRings collection with fn applied to each ring.
+func mapLinearRings(rings LinearRings, fn func(Vertices) Vertices) LinearRings {
+	out := make(LinearRings, len(rings))
+	for i, ring := range rings {
+		out[i] = LinearRing(fn(Vertices(ring)))
+	}
+	return out
+}
+
+// GeometriesEqual reports whether two geometries have the same type and exactly the same
+// positions, grouped in the same segments, rings and polygons. Two nil geometries are equal.
+// Declared bounding boxes and serialization flags are not compared.
+func GeometriesEqual(a, b Geometry) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	if a.Type() != b.Type() {
+		return false
+	}
+
+	switch v := a.(type) {
+	case *Point:
+		w := b.(*Point)
+		return v.coords.IsEqual(w.coords)
+	case *LineString:
+		return equalVertices(v.vertices, b.(*LineString).vertices)
+	case *MultiPoint:
+		return equalVertices(v.vertices, b.(*MultiPoint).vertices)
+	case *MultiLineString:
+		return slices.EqualFunc(v.segments, b.(*MultiLineString).segments, equalVertices)
+	case *Polygon:
+		return equalLinearRings(v.rings, b.(*Polygon).rings)
+	case *MultiPolygon:
+		return slices.EqualFunc(v.rings, b.(*MultiPolygon).rings, equalLinearRings)
+	case *GeometryCollection:
+		return slices.EqualFunc(v.geometries, b.(*GeometryCollection).geometries, GeometriesEqual)
+	default:
+		return false
+	}
+}
+
+// equalVertices reports whether two Vertices contain the same coordinates in the same order.
+func equalVertices(a, b Vertices) bool {
+	return slices.EqualFunc(a, b, func(x, y Coordinates) bool {
+		return x.IsEqual(y)
+	})
+}
+
+// equalLinearRings reports whether two LinearRings collections contain the same rings in the same order.
+func equalLinearRings(a, b LinearRings) bool {
+	return slices.EqualFunc(a, b, func(x, y LinearRing) bool {
+		return equalVertices(Vertices(x), Vertices(y))
+	})
+}
@@ -3,18 +3,26 @@ package geojson
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 var (
 	// ErrGeometryCollectionBuildCoordinates is returned when attempting to build coordinates
 	// for a GeometryCollection, which does not directly define coordinates.
 	ErrGeometryCollectionBuildCoordinates = fmt.Errorf("%s does not have coordinates to build", TypeGeometryCollection)
+
+	// ErrNestedGeometryCollection is returned when adding a GeometryCollection to another one,
+	// which RFC 7946 discourages.
+	ErrNestedGeometryCollection = fmt.Errorf("%s should not be nested", TypeGeometryCollection)
 )
 
 // GeometryCollection represents a GeoJSON GeometryCollection,
 // which is a collection of different geometry objects.
 type GeometryCollection struct {
-	geometries []Geometry // Slice of Geometry objects contained within the collection.
+	geometries    []Geometry // Slice of Geometry objects contained within the collection.
+	declaredBBox             // Bounding box declared in the decoded GeoJSON, if any.
+	SerializeBBox bool       // Indicates whether the computed bounding box should be included during JSON serialization.
+	PreserveBBox  bool       // Indicates whether the declared bounding box should be included when SerializeBBox is false.
 }
 
 // BoundingBox calculates and returns the BoundingBox for the entire GeometryCollection.
@@ -38,14 +46,70 @@ func (g *GeometryCollection) Type() GeometryType {
 	return TypeGeometryCollection
 }
 
+// AsGeometryObject wraps the GeometryCollection in a GeometryObject.
+func (g *GeometryCollection) AsGeometryObject() GeometryObject {
+	return FromGeometry(g)
+}
+
 // Geometries returns the slice of Geometry objects contained in the GeometryCollection.
 // It provides access to the individual geometries that make up the collection.
 func (g *GeometryCollection) Geometries() []Geometry {
 	return g.geometries
 }
 
+// Add appends the geometry to the GeometryCollection. It returns ErrGeometryNotDefined if the geometry
+// is nil, and ErrNestedGeometryCollection if it is a GeometryCollection.
+func (g *GeometryCollection) Add(geom Geometry) error {
+	switch geom.(type) {
+	case nil:
+		return ErrGeometryNotDefined
+	case *GeometryCollection:
+		return ErrNestedGeometryCollection
+	}
+
+	g.geometries = append(g.geometries, geom)
+	return nil
+}
+
+// RemoveAt removes the geometry at index i from the GeometryCollection, keeping the order of the others.
+// It returns ErrGeometryIndex if i is out of range.
+func (g *GeometryCollection) RemoveAt(i int) error {
+	if i < 0 || i >= len(g.geometries) {
+		return fmt.Errorf("%w: %d", ErrGeometryIndex, i)
+	}
+
+	g.geometries = slices.Delete(g.geometries, i, i+1)
+	return nil
+}
+
+// ToFeatureCollection returns a new FeatureCollection with a feature for each geometry of the
+// GeometryCollection, in order, without properties or ID. The geometries are shared, not copied.
+func (g *GeometryCollection) ToFeatureCollection() *FeatureCollection {
+	features := make([]Feature, len(g.geometries))
+	for i, geom := range g.geometries {
+		features[i] = Feature{Geometry: geom}
+	}
+	return NewFeatureCollectionFromFeatures(features)
+}
+
+// EstimatedJSONSize returns the approximate size in bytes of the GeoJSON representation
+// of the GeometryCollection, including all of its child geometries.
+func (g *GeometryCollection) EstimatedJSONSize() int {
+	size := 0
+	for _, child := range g.geometries {
+		size += estimateGeometryValueSize(child)
+	}
+
+	return estimateBBoxMemberSize(g.serializedBBox(g, g.SerializeBBox, g.PreserveBBox)) + estimateObjectSize(
+		estimateMemberSize("type", estimateStringSize(string(g.Type()))),
+		estimateMemberSize("geometries", estimateArraySize(size, len(g.geometries))),
+	)
+}
+
 // MarshalJSON serializes the GeometryCollection into GeoJSON format.
 // It outputs the type as "GeometryCollection" and includes child geometries, if any.
+// If SerializeBBox is true, the computed bounding box is included; otherwise, when PreserveBBox is true,
+// the bounding box declared in the decoded GeoJSON, if any, is emitted unchanged.
 func (g *GeometryCollection) MarshalJSON() ([]byte, error) {
 	geometries := make([]Geometry, 0)
 	if len(g.geometries) > 0 {
@@ -57,6 +121,8 @@ func (g *GeometryCollection) MarshalJSON() ([]byte, error) {
 		Geometries: geometries,
 	}
 
+	out.BBox = g.serializedBBox(g, g.SerializeBBox, g.PreserveBBox)
+
 	return json.Marshal(&out)
 }
 
@@ -64,25 +130,12 @@ func (g *GeometryCollection) MarshalJSON() ([]byte, error) {
 // It first unmarshals the data into a generic GeometryObject, validates its type,
 // and assigns the parsed geometries to the collection.
 func (g *GeometryCollection) UnmarshalJSON(data []byte) error {
-	gw := &GeometryObject{}
-
-	if err := gw.UnmarshalJSON(data); err != nil {
-		return fmt.Errorf("failed to unmarshal %s: %w", g.Type(), err)
-	}
-
-	gc, ok := gw.geometry.(*GeometryCollection)
-	if !ok {
-		return ErrInvalidTypeField
-	}
-
-	g.geometries = gc.geometries
-
-	return nil
+	return decodeGeometryInto(g, data, decodeOptions{})
 }
 
 // buildCoordinates returns an error because GeometryCollection does not directly define coordinates.
 // This satisfies the Geometry interface but is unsupported for GeometryCollection.
-func (g *GeometryCollection) buildCoordinates(_ interface{}) error {
+func (g *GeometryCollection) buildCoordinates(_ interface{}, _ decodeOptions) error {
 	return ErrGeometryCollectionBuildCoordinates
 }
 
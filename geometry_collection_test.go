@@ -85,7 +85,7 @@ func TestGeometryCollection_Type(t *testing.T) {
 
 func TestGeometryCollection_BuildCoordinates(t *testing.T) {
 	gc := NewGeometryCollection()
-	err := gc.buildCoordinates(nil)
+	err := gc.buildCoordinates(nil, decodeOptions{})
 	assert.ErrorIs(t, err, ErrGeometryCollectionBuildCoordinates)
 }
 
@@ -117,6 +117,45 @@ func TestGeometryCollection_MarshalJSON(t *testing.T) {
 	}
 }
 
+func TestGeometryCollection_MarshalJSON_BBox(t *testing.T) {
+	gc := NewGeometryCollectionFromSlice([]Geometry{
+		MustPoint([]float64{1, 1}),
+		MustLineString(Vertices{{-2, 0}, {3, 4}}),
+	})
+	gc.SerializeBBox = true
+
+	data, err := gc.MarshalJSON()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[1,1]},{"type":"LineString","coordinates":[[-2,0],[3,4]]}],"bbox":[-2,0,3,4]}`, string(data))
+}
+
+func TestGeometryCollection_UnmarshalJSON_BBox(t *testing.T) {
+	input := `{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[1,1]},{"type":"Point","coordinates":[2,3]}],"bbox":[0,0,5,5]}`
+
+	gc := NewGeometryCollection()
+	assert.NoError(t, gc.UnmarshalJSON([]byte(input)))
+	assert.Equal(t, BoundingBox{0, 0, 5, 5}, gc.bbox)
+	assert.Equal(t, TypePoint, gc.Geometries()[0].Type())
+
+	bbox, ok := gc.ParsedBBox()
+	assert.True(t, ok)
+	assert.Equal(t, BoundingBox{0, 0, 5, 5}, bbox)
+
+	data, err := gc.MarshalJSON()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[1,1]},{"type":"Point","coordinates":[2,3]}]}`, string(data), "declared bbox should be dropped by default")
+
+	gc.PreserveBBox = true
+	data, err = gc.MarshalJSON()
+	assert.NoError(t, err)
+	assert.JSONEq(t, input, string(data), "declared bbox should be preserved")
+
+	gc.SerializeBBox = true
+	data, err = gc.MarshalJSON()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[1,1]},{"type":"Point","coordinates":[2,3]}],"bbox":[1,1,2,3]}`, string(data), "computed bbox should take precedence")
+}
+
 func TestGeometryCollection_UnmarshalJSON(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -221,3 +260,36 @@ func TestGeometryCollection_Geometries(t *testing.T) {
 		})
 	}
 }
+
+func TestGeometryCollection_Add_RemoveAt(t *testing.T) {
+	point := MustPoint([]float64{1, 2})
+	line := MustLineString(Vertices{{0, 0}, {1, 1}})
+	polygon := MustPolygon(LinearRings{*MustLinearRing(Vertices{{0, 0}, {1, 0}, {1, 1}, {0, 0}})})
+
+	g := NewGeometryCollection()
+	assert.NoError(t, g.Add(point))
+	assert.NoError(t, g.Add(line))
+	assert.NoError(t, g.Add(polygon))
+	assert.ErrorIs(t, g.Add(NewGeometryCollection()), ErrNestedGeometryCollection)
+	assert.ErrorIs(t, g.Add(nil), ErrGeometryNotDefined)
+	assert.Equal(t, []Geometry{point, line, polygon}, g.Geometries())
+
+	assert.NoError(t, g.RemoveAt(1))
+	assert.Equal(t, []Geometry{point, polygon}, g.Geometries())
+
+	assert.ErrorIs(t, g.RemoveAt(2), ErrGeometryIndex)
+	assert.ErrorIs(t, g.RemoveAt(-1), ErrGeometryIndex)
+
+	assert.NoError(t, g.RemoveAt(0))
+	assert.NoError(t, g.RemoveAt(0))
+	assert.Empty(t, g.Geometries())
+}
+
+func TestGeometryCollection_ToFeatureCollection(t *testing.T) {
+	point := MustPoint([]float64{1, 2})
+	line := MustLineString(Vertices{{0, 0}, {1, 1}})
+
+	fc := NewGeometryCollectionFromSlice([]Geometry{point, line}).ToFeatureCollection()
+	assert.Equal(t, []Feature{{Geometry: point}, {Geometry: line}}, fc.Features)
+	assert.Empty(t, NewGeometryCollection().ToFeatureCollection().Features)
+}
@@ -3,6 +3,9 @@ package geojson
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"math"
+	"slices"
 )
 
 var (
@@ -22,6 +25,7 @@ var (
 // GeometryObject represents a GeoJSON Geometry Object.
 type GeometryObject struct {
 	geometry Geometry
+	id       *ID // Non-standard identifier decoded with UnmarshalOptions.Lenient, if any.
 }
 
 // Type returns the geometry type of the GeometryObject.
@@ -39,46 +43,154 @@ func (g *GeometryObject) MarshalJSON() ([]byte, error) {
 		return nil, ErrGeometryNotDefined
 	}
 
-	return json.Marshal(g.geometry)
+	data, err := json.Marshal(g.geometry)
+	if err != nil || g.id == nil {
+		return data, err
+	}
+
+	id, err := json.Marshal(g.id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Append the id as the last member of the geometry object.
+	out := append(data[:len(data)-1:len(data)-1], `,"id":`...)
+	out = append(out, id...)
+	return append(out, '}'), nil
+}
+
+// ID returns the identifier declared on the geometry object, and a boolean indicating whether one was present.
+// RFC 7946 defines identifiers on features only, so the id member of geometries is kept only when decoding
+// with UnmarshalOptions.Lenient, and is then written back when the GeometryObject is encoded.
+func (g *GeometryObject) ID() (*ID, bool) {
+	return g.id, g.id != nil
 }
 
 // UnmarshalJSON unmarshals JSON data into the GeometryObject.
 func (g *GeometryObject) UnmarshalJSON(bytes []byte) error {
+	return g.decode(bytes, decodeOptions{})
+}
+
+// decode unmarshals JSON data into the GeometryObject applying the decoding options.
+func (g *GeometryObject) decode(bytes []byte, opts decodeOptions) error {
+	v, err := decodeGeometry(bytes, opts)
+	if err != nil {
+		return err
+	}
+
+	g.geometry = v
+	g.id = nil
+
+	if opts.lenient {
+		var input idJSONInput
+		if err := json.Unmarshal(bytes, &input); err != nil {
+			return err
+		}
+		g.id = input.ID
+	}
+
+	return nil
+}
+
+// UnmarshalGeometry decodes a GeoJSON geometry of any type and returns it as its concrete type, such as
+// *Point or *Polygon, which a type switch can then tell apart. It returns ErrInvalidTypeField for missing
+// or unknown types, and the same errors as GeometryObject.UnmarshalJSON for invalid geometries.
+func UnmarshalGeometry(data []byte) (Geometry, error) {
+	return decodeGeometry(data, decodeOptions{})
+}
+
+// decodeGeometry decodes a GeoJSON geometry, applying the decoding options to its positions
+// and to those of the geometries it contains.
+func decodeGeometry(bytes []byte, opts decodeOptions) (Geometry, error) {
 	geometry := geometryJSONInput{}
 	if err := json.Unmarshal(bytes, &geometry); err != nil {
-		return err
+		return nil, err
+	}
+
+	if !geometry.BBox.IsValid() {
+		return nil, ErrInvalidBBox
 	}
 
 	var v Geometry
 	switch geometry.Type {
 	case TypePoint:
-		v = &Point{}
+		v = &Point{declaredBBox: declaredBBox{geometry.BBox}}
 	case TypeLineString:
-		v = &LineString{}
+		v = &LineString{declaredBBox: declaredBBox{geometry.BBox}}
 	case TypeMultiPoint:
-		v = &MultiPoint{}
+		v = &MultiPoint{declaredBBox: declaredBBox{geometry.BBox}}
 	case TypeMultiLineString:
-		v = &MultiLineString{}
+		v = &MultiLineString{declaredBBox: declaredBBox{geometry.BBox}}
 	case TypePolygon:
-		v = &Polygon{}
+		v = &Polygon{declaredBBox: declaredBBox{geometry.BBox}}
 	case TypeMultiPolygon:
-		v = &MultiPolygon{}
+		v = &MultiPolygon{declaredBBox: declaredBBox{geometry.BBox}}
 	case TypeGeometryCollection:
-		gc := &GeometryCollection{}
-		for _, gm := range geometry.Geometries {
-			gc.geometries = append(gc.geometries, gm.geometry)
+		gc := &GeometryCollection{declaredBBox: declaredBBox{geometry.BBox}}
+		for _, raw := range geometry.Geometries {
+			child, err := decodeGeometry(raw, opts)
+			if err != nil {
+				return nil, err
+			}
+			gc.geometries = append(gc.geometries, child)
 		}
-		g.geometry = gc
-		return nil
+		return gc, nil
 	default:
-		return ErrInvalidTypeField
+		return nil, ErrInvalidTypeField
 	}
 
-	if err := v.buildCoordinates(geometry.Coordinates); err != nil {
-		return err
+	if err := v.buildCoordinates(geometry.Coordinates, opts); err != nil {
+		return nil, err
 	}
 
-	g.geometry = v
+	return v, nil
+}
+
+// decodeGeometryInto decodes JSON data into dst applying the decoding options. The decoded geometry must be
+// of the same type as dst, whose positions and declared bounding box are replaced while its serialization
+// flags are kept.
+func decodeGeometryInto(dst Geometry, data []byte, opts decodeOptions) error {
+	v, err := decodeGeometry(data, opts)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal %s: %w", dst.Type(), err)
+	}
+
+	if v.Type() != dst.Type() {
+		return ErrInvalidTypeField
+	}
+
+	switch dst := dst.(type) {
+	case *Point:
+		src := v.(*Point)
+		dst.coords = src.coords
+		dst.bbox = src.bbox
+	case *LineString:
+		src := v.(*LineString)
+		dst.vertices = src.vertices
+		dst.bbox = src.bbox
+	case *MultiPoint:
+		src := v.(*MultiPoint)
+		dst.vertices = src.vertices
+		dst.bbox = src.bbox
+	case *MultiLineString:
+		src := v.(*MultiLineString)
+		dst.segments = src.segments
+		dst.bbox = src.bbox
+	case *Polygon:
+		src := v.(*Polygon)
+		dst.rings = src.rings
+		dst.bbox = src.bbox
+		dst.InvalidateBBox()
+	case *MultiPolygon:
+		src := v.(*MultiPolygon)
+		dst.rings = src.rings
+		dst.bbox = src.bbox
+		dst.InvalidateBBox()
+	case *GeometryCollection:
+		src := v.(*GeometryCollection)
+		dst.geometries = src.geometries
+		dst.bbox = src.bbox
+	}
 
 	return nil
 }
@@ -221,6 +333,85 @@ func (g *GeometryObject) ToGeometryCollection() (*GeometryCollection, error) {
 	return v, nil
 }
 
+// Walk calls fn for every position of the geometry in depth-first order, recursing into the children
+// of a GeometryCollection, and stops early as soon as fn returns false. Empty objects visit nothing.
+func (g *GeometryObject) Walk(fn func(c Coordinates) bool) {
+	walkGeometry(g.geometry, fn)
+}
+
+// NearestVertex returns a copy of the position of the geometry closest to the given coordinates, and its
+// great-circle distance in meters computed with the haversine formula, ignoring altitude. Positions are visited
+// as by Walk, and the first one is returned when several are equally close. The boolean reports whether the
+// geometry has any position; it is false for empty objects.
+func (g *GeometryObject) NearestVertex(to Coordinates) (Coordinates, float64, bool) {
+	var nearest Coordinates
+	minDistance := math.Inf(1)
+	g.Walk(func(c Coordinates) bool {
+		if d := c.Distance(to); nearest == nil || d < minDistance {
+			nearest, minDistance = c, d
+		}
+		return true
+	})
+
+	if nearest == nil {
+		return nil, 0, false
+	}
+	return slices.Clone(nearest), minDistance, true
+}
+
+// MapCoordinates replaces every position of the geometry, in place, with the result of fn, which receives
+// a copy of each position in the order visited by Walk. The structure of the geometry is preserved, and polygon
+// rings are oriented again following the right-hand rule. If any transformed position has an invalid size or
+// an out-of-range longitude or latitude, an error is returned and the geometry is left unchanged.
+func (g *GeometryObject) MapCoordinates(fn func(Coordinates) Coordinates) error {
+	if g.IsEmpty() {
+		return ErrGeometryNotDefined
+	}
+
+	var mapped Vertices
+	var err error
+	walkGeometry(g.geometry, func(c Coordinates) bool {
+		var coords *Coordinates
+		if coords, err = NewCoordinates(fn(slices.Clone(c))); err != nil {
+			err = fmt.Errorf("failed to map position %d: %w", len(mapped), err)
+			return false
+		}
+		mapped = append(mapped, *coords)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	replaceCoordinates(g.geometry, mapped)
+	return nil
+}
+
+// EqualSnapped reports whether the GeometryObject and the other geometry are equal after snapping
+// the longitude and latitude of both to a grid of the given size, expressed in degrees.
+// Consecutive positions that collapse onto the same grid cell are merged before comparing,
+// so geometries whose vertex counts differ slightly after processing can still compare equal.
+// Altitude values are not snapped and must match exactly.
+//
+// Limitations: values very close to each other but on opposite sides of a grid cell boundary
+// snap to different cells and compare unequal, the starting vertex of a ring is significant,
+// and a non-positive gridSize falls back to exact comparison.
+func (g *GeometryObject) EqualSnapped(other Geometry, gridSize float64) bool {
+	if g.IsEmpty() || other == nil {
+		return g.IsEmpty() && other == nil
+	}
+
+	if gridSize <= 0 {
+		return GeometriesEqual(g.geometry, other)
+	}
+
+	snap := func(v Vertices) Vertices {
+		return snapVertices(v, gridSize)
+	}
+
+	return GeometriesEqual(mapGeometry(g.geometry, snap), mapGeometry(other, snap))
+}
+
 // FromGeometry creates and returns a new GeometryObject given a Geometry.
 // The input Geometry is assigned to the geometry field of the GeometryObject.
 func FromGeometry(g Geometry) GeometryObject {
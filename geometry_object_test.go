@@ -79,6 +79,41 @@ func TestGeometryObject_UnmarshalJSON(t *testing.T) {
 	}
 }
 
+func TestUnmarshalGeometry(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    Geometry
+		expectError error
+	}{
+		{"point", `{"type": "Point", "coordinates": [1.0, 2.0]}`, MustPoint([]float64{1, 2}), nil},
+		{"line string", `{"type": "LineString", "coordinates": [[1, 2], [3, 4]]}`, MustLineString(Vertices{{1, 2}, {3, 4}}), nil},
+		{
+			name:     "geometry collection",
+			input:    `{"type": "GeometryCollection", "geometries": [{"type": "MultiPoint", "coordinates": [[1, 2]]}]}`,
+			expected: NewGeometryCollectionFromSlice([]Geometry{NewMultiPointFromVertices(Vertices{{1, 2}})}),
+		},
+		{"unknown type", `{"type": "Circle", "coordinates": [1, 2]}`, nil, ErrInvalidTypeField},
+		{"missing type", `{"coordinates": [1, 2]}`, nil, ErrInvalidTypeField},
+		{"invalid coordinates", `{"type": "Point", "coordinates": [1]}`, nil, ErrCoordinatesSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := UnmarshalGeometry([]byte(tt.input))
+			if tt.expectError != nil {
+				assert.ErrorIs(t, err, tt.expectError)
+				assert.Nil(t, g)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.IsType(t, tt.expected, g)
+			assert.True(t, GeometriesEqual(tt.expected, g))
+		})
+	}
+}
+
 func TestGeometryObject_IsTypeChecks(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -199,3 +234,278 @@ func TestFromGeometry(t *testing.T) {
 		})
 	}
 }
+
+func TestGeometryObject_EqualSnapped(t *testing.T) {
+	tests := []struct {
+		name     string
+		geometry Geometry
+		other    Geometry
+		gridSize float64
+		expected bool
+	}{
+		{
+			name:     "points within the same cell",
+			geometry: MustPoint([]float64{10.0001, 20.0002}),
+			other:    MustPoint([]float64{9.9999, 19.9998}),
+			gridSize: 0.001,
+			expected: true,
+		},
+		{
+			name:     "points in different cells",
+			geometry: MustPoint([]float64{10.01, 20}),
+			other:    MustPoint([]float64{10, 20}),
+			gridSize: 0.001,
+			expected: false,
+		},
+		{
+			name:     "line strings with an extra near-duplicate vertex",
+			geometry: MustLineString(Vertices{{0, 0}, {1, 1}, {2, 2}}),
+			other:    MustLineString(Vertices{{0, 0}, {1.00001, 1}, {1, 1.00001}, {2, 2}}),
+			gridSize: 0.001,
+			expected: true,
+		},
+		{
+			name: "polygons",
+			geometry: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{0, 0}, {10, 0}, {10, 10}, {0, 0}}),
+			}),
+			other: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{0.0001, 0}, {10, 0.0001}, {10, 10}, {0.0001, 0}}),
+			}),
+			gridSize: 0.01,
+			expected: true,
+		},
+		{
+			name:     "different types",
+			geometry: MustPoint([]float64{0, 0}),
+			other:    NewMultiPointFromVertices(Vertices{{0, 0}}),
+			gridSize: 0.01,
+			expected: false,
+		},
+		{
+			name:     "altitude is not snapped",
+			geometry: MustPoint([]float64{0, 0, 10}),
+			other:    MustPoint([]float64{0, 0, 10.0001}),
+			gridSize: 0.01,
+			expected: false,
+		},
+		{
+			name:     "non-positive grid size compares exactly",
+			geometry: MustPoint([]float64{0, 0}),
+			other:    MustPoint([]float64{0, 0.0001}),
+			gridSize: 0,
+			expected: false,
+		},
+		{
+			name: "geometry collections",
+			geometry: NewGeometryCollectionFromSlice([]Geometry{
+				MustPoint([]float64{1, 1}),
+				MustLineString(Vertices{{0, 0}, {1, 1}}),
+			}),
+			other: NewGeometryCollectionFromSlice([]Geometry{
+				MustPoint([]float64{1.0001, 1}),
+				MustLineString(Vertices{{0, 0.0001}, {1, 1}}),
+			}),
+			gridSize: 0.01,
+			expected: true,
+		},
+		{
+			name:     "empty geometry",
+			geometry: nil,
+			other:    MustPoint([]float64{0, 0}),
+			gridSize: 0.01,
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := GeometryObject{geometry: test.geometry}
+			assert.Equal(t, test.expected, g.EqualSnapped(test.other, test.gridSize))
+		})
+	}
+}
+
+func TestGeometryObject_EqualSnapped_DoesNotModifyGeometries(t *testing.T) {
+	l := MustLineString(Vertices{{0.0001, 0}, {1, 1}})
+	g := FromGeometry(l)
+
+	assert.True(t, g.EqualSnapped(MustLineString(Vertices{{0, 0}, {1, 1}}), 0.01))
+	assert.Equal(t, Vertices{{0.0001, 0}, {1, 1}}, l.Vertices())
+}
+
+func TestGeometry_AsGeometryObject(t *testing.T) {
+	point := MustPoint([]float64{1, 2})
+	lineString := MustLineString(Vertices{{0, 0}, {1, 1}})
+	multiPoint := NewMultiPointFromVertices(Vertices{{0, 0}, {1, 1}})
+	multiLineString := MustMultiLineString(Segments{{{0, 0}, {1, 1}}})
+	polygon := MustPolygon(LinearRings{*MustLinearRing(Vertices{{0, 0}, {1, 0}, {1, 1}, {0, 0}})})
+	multiPolygon := MustMultiPolygonFromRingSlice([]LinearRings{polygon.LinearRings()})
+	collection := NewGeometryCollectionFromSlice([]Geometry{point})
+
+	tests := []struct {
+		name     string
+		object   GeometryObject
+		geometry Geometry
+	}{
+		{"Point", point.AsGeometryObject(), point},
+		{"LineString", lineString.AsGeometryObject(), lineString},
+		{"MultiPoint", multiPoint.AsGeometryObject(), multiPoint},
+		{"MultiLineString", multiLineString.AsGeometryObject(), multiLineString},
+		{"Polygon", polygon.AsGeometryObject(), polygon},
+		{"MultiPolygon", multiPolygon.AsGeometryObject(), multiPolygon},
+		{"GeometryCollection", collection.AsGeometryObject(), collection},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.geometry.Type(), test.object.Type())
+			assert.Same(t, test.geometry, test.object.geometry)
+		})
+	}
+}
+
+func TestGeometryObject_UnmarshalJSON_ParsedBBox(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected BoundingBox
+	}{
+		{"Point", `{"type":"Point","coordinates":[1,2],"bbox":[0,0,5,5]}`, BoundingBox{0, 0, 5, 5}},
+		{"LineString", `{"type":"LineString","coordinates":[[1,2],[3,4]],"bbox":[1,2,0,3,4,0]}`, BoundingBox{1, 2, 0, 3, 4, 0}},
+		{"MultiPoint", `{"type":"MultiPoint","coordinates":[[1,2]],"bbox":[1,2,1,2]}`, BoundingBox{1, 2, 1, 2}},
+		{"MultiLineString", `{"type":"MultiLineString","coordinates":[[[1,2],[3,4]]],"bbox":[1,2,3,4]}`, BoundingBox{1, 2, 3, 4}},
+		{"Polygon", `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]],"bbox":[0,0,1,1]}`, BoundingBox{0, 0, 1, 1}},
+		{"MultiPolygon", `{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,0]]]],"bbox":[0,0,1,1]}`, BoundingBox{0, 0, 1, 1}},
+		{"GeometryCollection", `{"type":"GeometryCollection","geometries":[],"bbox":[0,0,1,1]}`, BoundingBox{0, 0, 1, 1}},
+		{"no bbox", `{"type":"Point","coordinates":[1,2]}`, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var g GeometryObject
+			require.NoError(t, g.UnmarshalJSON([]byte(test.input)))
+
+			parsed, ok := g.geometry.(interface {
+				ParsedBBox() (BoundingBox, bool)
+			})
+			require.True(t, ok)
+
+			bbox, found := parsed.ParsedBBox()
+			assert.Equal(t, test.expected != nil, found)
+			assert.Equal(t, test.expected, bbox)
+		})
+	}
+}
+
+func TestGeometryObject_UnmarshalJSON_InvalidBBox(t *testing.T) {
+	inputs := []string{
+		`{"type":"Point","coordinates":[1,2],"bbox":[0,0,5]}`,
+		`{"type":"LineString","coordinates":[[1,2],[3,4]],"bbox":[1,2,3,4,5]}`,
+		`{"type":"GeometryCollection","geometries":[],"bbox":[1]}`,
+	}
+
+	for _, input := range inputs {
+		var g GeometryObject
+		assert.ErrorIs(t, g.UnmarshalJSON([]byte(input)), ErrInvalidBBox, input)
+	}
+}
+
+func TestGeometryObject_Walk(t *testing.T) {
+	collection := NewGeometryCollectionFromSlice([]Geometry{
+		MustPoint([]float64{1, 1}),
+		MustMultiLineString(Segments{{{2, 2}, {3, 3}}, {{4, 4}, {5, 5}}}),
+		NewGeometryCollectionFromSlice([]Geometry{
+			MustPolygon(LinearRings{*MustLinearRing(Vertices{{0, 0}, {1, 0}, {1, 1}, {0, 0}})}),
+		}),
+	})
+	object := collection.AsGeometryObject()
+
+	var visited Vertices
+	object.Walk(func(c Coordinates) bool {
+		visited = append(visited, c)
+		return true
+	})
+	assert.Equal(t, Vertices{{1, 1}, {2, 2}, {3, 3}, {4, 4}, {5, 5}, {0, 0}, {1, 0}, {1, 1}, {0, 0}}, visited)
+
+	visited = nil
+	object.Walk(func(c Coordinates) bool {
+		visited = append(visited, c)
+		return len(visited) < 3
+	})
+	assert.Equal(t, Vertices{{1, 1}, {2, 2}, {3, 3}}, visited)
+
+	empty := GeometryObject{}
+	empty.Walk(func(Coordinates) bool {
+		t.Fatal("unexpected position in empty geometry")
+		return true
+	})
+}
+
+func TestGeometryObject_NearestVertex(t *testing.T) {
+	collection := NewGeometryCollectionFromSlice([]Geometry{
+		MustPoint([]float64{10, 10}),
+		MustLineString(Vertices{{0, 0}, {1, 0, 50}, {2, 0}}),
+	})
+	object := collection.AsGeometryObject()
+
+	nearest, distance, ok := object.NearestVertex(Coordinates{1.1, 0.1})
+	require.True(t, ok)
+	assert.Equal(t, Coordinates{1, 0, 50}, nearest)
+	expected := Coordinates{1, 0}
+	assert.InDelta(t, expected.Distance(Coordinates{1.1, 0.1}), distance, 1e-9)
+
+	// The returned position is a copy.
+	nearest[0] = 99
+	assert.Equal(t, Vertices{{10, 10}, {0, 0}, {1, 0, 50}, {2, 0}}, collection.Vertices())
+
+	nearest, distance, ok = object.NearestVertex(Coordinates{10, 10})
+	require.True(t, ok)
+	assert.Equal(t, Coordinates{10, 10}, nearest)
+	assert.Zero(t, distance)
+
+	empty := GeometryObject{}
+	nearest, distance, ok = empty.NearestVertex(Coordinates{0, 0})
+	assert.False(t, ok)
+	assert.Nil(t, nearest)
+	assert.Zero(t, distance)
+}
+
+func TestGeometryObject_MapCoordinates(t *testing.T) {
+	offset := func(c Coordinates) Coordinates {
+		c[idxCoordsLng] += 10
+		c[idxCoordsLat] += 5
+		return c
+	}
+
+	collection := NewGeometryCollectionFromSlice([]Geometry{
+		MustPoint([]float64{1, 1, 100}),
+		MustMultiLineString(Segments{{{2, 2}, {3, 3}}, {{4, 4}, {5, 5}}}),
+	})
+	object := collection.AsGeometryObject()
+	require.NoError(t, object.MapCoordinates(offset))
+
+	assert.Equal(t, Coordinates{11, 6, 100}, collection.geometries[0].(*Point).Coordinates())
+	assert.Equal(t, Segments{{{12, 7}, {13, 8}}, {{14, 9}, {15, 10}}}, collection.geometries[1].(*MultiLineString).Segments())
+
+	// Mirroring reverses the orientation of the rings, which must be restored.
+	polygon := MustPolygon(LinearRings{*MustLinearRing(Vertices{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}})})
+	object = polygon.AsGeometryObject()
+	require.NoError(t, object.MapCoordinates(func(c Coordinates) Coordinates {
+		return Coordinates{-c[idxCoordsLng], c[idxCoordsLat]}
+	}))
+	assert.Equal(t, Vertices{{0, 0}, {0, 1}, {-1, 1}, {-1, 0}, {0, 0}}, polygon.Vertices())
+	assert.True(t, polygon.LinearRings()[0].IsCounterClockwise())
+
+	line := MustLineString(Vertices{{170, 0}, {175, 0}, {179, 0}})
+	object = line.AsGeometryObject()
+	err := object.MapCoordinates(offset)
+	assert.ErrorIs(t, err, ErrLongitudeRange)
+	assert.Equal(t, Vertices{{170, 0}, {175, 0}, {179, 0}}, line.Vertices())
+
+	err = object.MapCoordinates(func(Coordinates) Coordinates { return Coordinates{1} })
+	assert.ErrorIs(t, err, ErrCoordinatesSize)
+
+	empty := GeometryObject{}
+	assert.ErrorIs(t, empty.MapCoordinates(offset), ErrGeometryNotDefined)
+}
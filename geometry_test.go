@@ -0,0 +1,88 @@
+package geojson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinimumVertices(t *testing.T) {
+	tests := []struct {
+		geometryType GeometryType
+		expected     int
+	}{
+		{TypePoint, 1},
+		{TypeLineString, 2},
+		{TypeMultiPoint, 0},
+		{TypeMultiLineString, 2},
+		{TypePolygon, 4},
+		{TypeMultiPolygon, 0},
+		{TypeGeometryCollection, 0},
+		{TypeEmptyGeometry, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.geometryType), func(t *testing.T) {
+			assert.Equal(t, tt.expected, MinimumVertices(tt.geometryType))
+		})
+	}
+}
+
+func TestValidateVertexCount(t *testing.T) {
+	tests := []struct {
+		name         string
+		geometryType GeometryType
+		n            int
+		expectedErr  error
+	}{
+		{"valid point", TypePoint, 1, nil},
+		{"empty point", TypePoint, 0, ErrVertexCount},
+		{"valid line string", TypeLineString, 2, nil},
+		{"short line string", TypeLineString, 1, ErrVertexCount},
+		{"short polygon", TypePolygon, 3, ErrVertexCount},
+		{"valid polygon", TypePolygon, 4, nil},
+		{"empty multi point", TypeMultiPoint, 0, nil},
+		{"unknown type", GeometryType("Circle"), 10, ErrInvalidTypeField},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateVertexCount(tt.geometryType, tt.n)
+			if tt.expectedErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestGeometriesEqual(t *testing.T) {
+	ring := LinearRings{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}
+
+	tests := []struct {
+		name     string
+		a, b     Geometry
+		expected bool
+	}{
+		{"both nil", nil, nil, true},
+		{"one nil", MustPoint([]float64{1, 2}), nil, false},
+		{"same point", MustPoint([]float64{1, 2}), &Point{coords: Coordinates{1, 2}, SerializeBBox: true}, true},
+		{"different altitude", MustPoint([]float64{1, 2}), MustPoint([]float64{1, 2, 0}), false},
+		{"different type", MustPoint([]float64{1, 2}), NewMultiPointFromVertices(Vertices{{1, 2}}), false},
+		{"same polygon", MustPolygon(ring), &Polygon{rings: LinearRings{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}}, true},
+		{
+			name:     "nested collections",
+			a:        NewGeometryCollectionFromSlice([]Geometry{MustPolygon(ring)}),
+			b:        NewGeometryCollectionFromSlice([]Geometry{MustPoint([]float64{0, 0})}),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, GeometriesEqual(tt.a, tt.b))
+			assert.Equal(t, tt.expected, GeometriesEqual(tt.b, tt.a))
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package geojson
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"math"
+	"strconv"
 )
 
 var (
@@ -11,9 +14,11 @@ var (
 )
 
 // ID represents a GeoJSON feature ID which can be either a string or a number.
+// Numbers are held either as integers, which serialize exactly, or as floating-point values.
 type ID struct {
 	s *string  // String ID value.
 	n *float64 // Numeric ID value.
+	i *int64   // Integer ID value.
 }
 
 // NewStringID creates a new ID instance initialized with a string value.
@@ -26,6 +31,11 @@ func NewNumericID(n float64) *ID {
 	return &ID{n: &n}
 }
 
+// NewIntID creates a new ID instance initialized with an integer value, which is serialized exactly.
+func NewIntID(i int64) *ID {
+	return &ID{i: &i}
+}
+
 // StringValue retrieves the string value of the ID.
 // Returns the value and a boolean indicating if the value is set.
 func (id *ID) StringValue() (string, bool) {
@@ -37,13 +47,110 @@ func (id *ID) StringValue() (string, bool) {
 
 // NumberValue retrieves the numeric value of the ID.
 // Returns the value and a boolean indicating if the value is set.
+// Integer IDs are converted to the nearest float64; use IntValue to retrieve them exactly.
 func (id *ID) NumberValue() (float64, bool) {
 	if id.n != nil {
 		return *id.n, true
 	}
+	if id.i != nil {
+		return float64(*id.i), true
+	}
 	return 0, false
 }
 
+// IntValue retrieves the integer value of the ID.
+// Returns the value and a boolean indicating if the ID holds an integer, or a floating-point
+// value with no fractional part that fits in an int64.
+func (id *ID) IntValue() (int64, bool) {
+	if id.i != nil {
+		return *id.i, true
+	}
+	if id.n != nil && *id.n == math.Trunc(*id.n) && *id.n >= math.MinInt64 && *id.n < math.MaxInt64 {
+		return int64(*id.n), true
+	}
+	return 0, false
+}
+
+// Equal reports whether the ID and the other ID hold the same value.
+// A string ID never equals a numeric ID, even if they represent the same digits, while integer and
+// floating-point IDs are equal when they hold the same number. Two nil IDs are considered equal.
+func (id *ID) Equal(other *ID) bool {
+	if id == nil || other == nil {
+		return id == nil && other == nil
+	}
+
+	switch {
+	case id.s != nil && other.s != nil:
+		return *id.s == *other.s
+	case id.isNumeric() && other.isNumeric():
+		a, aok := id.IntValue()
+		b, bok := other.IntValue()
+		if aok && bok {
+			return a == b
+		}
+		x, _ := id.NumberValue()
+		y, _ := other.NumberValue()
+		return x == y
+	default:
+		return id.s == nil && !id.isNumeric() && other.s == nil && !other.isNumeric()
+	}
+}
+
+// isNumeric reports whether the ID holds a number, either integer or floating-point.
+func (id *ID) isNumeric() bool {
+	return id.n != nil || id.i != nil
+}
+
+// clone returns a copy of the ID that does not share its value with the original, or nil if the ID is nil.
+func (id *ID) clone() *ID {
+	if id == nil {
+		return nil
+	}
+
+	clone := &ID{}
+	if id.s != nil {
+		s := *id.s
+		clone.s = &s
+	}
+	if id.n != nil {
+		n := *id.n
+		clone.n = &n
+	}
+	if id.i != nil {
+		i := *id.i
+		clone.i = &i
+	}
+	return clone
+}
+
+// key returns a string uniquely identifying the value of the ID, suitable as a map key.
+func (id *ID) key() string {
+	if id.s != nil {
+		return "s:" + *id.s
+	}
+	if i, ok := id.IntValue(); ok {
+		return "n:" + strconv.FormatInt(i, 10)
+	}
+	if id.n != nil {
+		return "n:" + strconv.FormatFloat(*id.n, 'g', -1, 64)
+	}
+	return ""
+}
+
+// estimatedJSONSize returns the approximate size in bytes of the JSON representation of the ID.
+func (id *ID) estimatedJSONSize() int {
+	if id.s != nil {
+		return estimateStringSize(*id.s)
+	}
+	if id.n != nil {
+		return estimateNumberSize(*id.n)
+	}
+	if id.i != nil {
+		return len(strconv.FormatInt(*id.i, 10))
+	}
+	return jsonNullSize
+}
+
 // MarshalJSON serializes the ID into its JSON representation.
 // It supports both string and numeric values.
 func (id *ID) MarshalJSON() ([]byte, error) {
@@ -53,22 +160,38 @@ func (id *ID) MarshalJSON() ([]byte, error) {
 	if id.n != nil {
 		return json.Marshal(*id.n)
 	}
+	if id.i != nil {
+		return strconv.AppendInt(nil, *id.i, 10), nil
+	}
 	return json.Marshal(nil)
 }
 
 // UnmarshalJSON deserializes a JSON value into the ID instance.
 // It supports both string and numeric types, returning an error for invalid types.
-func (id *ID) UnmarshalJSON(bytes []byte) error {
+// Numbers written without a fraction or exponent that fit in an int64 are decoded as integer IDs.
+func (id *ID) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
 	var v interface{}
-	if err := json.Unmarshal(bytes, &v); err != nil {
+	if err := decoder.Decode(&v); err != nil {
 		return fmt.Errorf("failed to unmarshal ID: %w", err)
 	}
 
 	switch value := v.(type) {
 	case string:
 		*id = *NewStringID(value)
-	case float64:
-		*id = *NewNumericID(value)
+	case json.Number:
+		if i, err := strconv.ParseInt(string(value), 10, 64); err == nil {
+			*id = *NewIntID(i)
+			return nil
+		}
+
+		n, err := value.Float64()
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal ID: %w", err)
+		}
+		*id = *NewNumericID(n)
 	default:
 		return ErrInvalidID
 	}
@@ -36,6 +36,7 @@ func TestID_NumberValue(t *testing.T) {
 		ok       bool
 	}{
 		{"numeric value", NewNumericID(42), 42, true},
+		{"integer value", NewIntID(42), 42, true},
 		{"no numeric value", NewStringID("test"), 0, false},
 	}
 
@@ -48,6 +49,29 @@ func TestID_NumberValue(t *testing.T) {
 	}
 }
 
+func TestID_IntValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       *ID
+		expected int64
+		ok       bool
+	}{
+		{"integer value", NewIntID(9007199254740993), 9007199254740993, true},
+		{"integral numeric value", NewNumericID(42), 42, true},
+		{"fractional numeric value", NewNumericID(4.2), 0, false},
+		{"numeric value out of range", NewNumericID(1e21), 0, false},
+		{"no numeric value", NewStringID("test"), 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			val, ok := tt.id.IntValue()
+			assert.Equal(t, tt.expected, val)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
+
 func TestID_MarshalJSON(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -56,6 +80,7 @@ func TestID_MarshalJSON(t *testing.T) {
 	}{
 		{"string ID", NewStringID("test"), `"test"`},
 		{"numeric ID", NewNumericID(42), `42`},
+		{"integer ID", NewIntID(9007199254740993), `9007199254740993`},
 		{"nil ID", &ID{}, `null`},
 		{"nil ID", nil, `null`},
 	}
@@ -77,7 +102,10 @@ func TestID_UnmarshalJSON(t *testing.T) {
 		expectError bool
 	}{
 		{"valid string", `"test"`, NewStringID("test"), false},
-		{"int number", `42`, NewNumericID(42), false},
+		{"int number", `42`, NewIntID(42), false},
+		{"large int number", `9007199254740993`, NewIntID(9007199254740993), false},
+		{"int number out of int64 range", `1e21`, NewNumericID(1e21), false},
+		{"negative int number", `-7`, NewIntID(-7), false},
 		{"null value", `null`, nil, false},
 		{"invalid value", `true`, nil, true},
 		{"empty JSON", `{}`, nil, true},
@@ -98,3 +126,31 @@ func TestID_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestID_Equal(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       *ID
+		other    *ID
+		expected bool
+	}{
+		{"same string", NewStringID("a"), NewStringID("a"), true},
+		{"different string", NewStringID("a"), NewStringID("b"), false},
+		{"same number", NewNumericID(1), NewNumericID(1), true},
+		{"different number", NewNumericID(1), NewNumericID(2), false},
+		{"string and number", NewStringID("1"), NewNumericID(1), false},
+		{"same integer", NewIntID(9007199254740993), NewIntID(9007199254740993), true},
+		{"different integer", NewIntID(9007199254740993), NewIntID(9007199254740992), false},
+		{"integer and number", NewIntID(1), NewNumericID(1), true},
+		{"integer and fractional number", NewIntID(1), NewNumericID(1.5), false},
+		{"string and integer", NewStringID("1"), NewIntID(1), false},
+		{"both nil", nil, nil, true},
+		{"one nil", NewStringID("a"), nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.id.Equal(tt.other))
+		})
+	}
+}
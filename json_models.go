@@ -1,41 +1,54 @@
 package geojson
 
+import "encoding/json"
+
+// typeJSONInput captures only the type member of a GeoJSON object, to tell what kind of object it is.
+type typeJSONInput struct {
+	Type string `json:"type"` // Specifies the type of the GeoJSON object.
+}
+
+// idJSONInput captures only the id member of a GeoJSON object, used for the non-standard id of geometries.
+type idJSONInput struct {
+	ID *ID `json:"id"` // Optional identifier of the GeoJSON object.
+}
+
 // featuresJSONInput represents the input structure for a GeoJSON object,
 // used to deserialize both single features and feature collections.
 type featuresJSONInput struct {
 	Type       ObjectType      `json:"type"`       // Specifies the type of GeoJSON object (e.g., "Feature" or "FeatureCollection").
-	Geometry   *GeometryObject `json:"geometry"`   // Contains the geometry of the GeoJSON feature (if applicable).
+	Geometry   json.RawMessage `json:"geometry"`   // The raw geometry member of the GeoJSON feature (if applicable).
 	Properties Properties      `json:"properties"` // Describes additional properties of the GeoJSON feature.
 	ID         *ID             `json:"id"`         // Optional identifier for the GeoJSON feature.
-	Features   []Feature       `json:"features"`   // An array of features (used if part of a feature collection).
+	Features   json.RawMessage `json:"features"`   // The raw features member (used if part of a feature collection).
+	BBox       BoundingBox     `json:"bbox"`       // Optional bounding box declared by the feature collection.
 }
 
 // featureCollectionJSONOutput represents the output structure of a GeoJSON FeatureCollection.
 // It contains a collection of features and, optionally, a bounding box.
 type featureCollectionJSONOutput struct {
-	Type     ObjectType  `json:"type"`           // Specifies the type of GeoJSON object (e.g., "FeatureCollection").
-	Features []Feature   `json:"features"`       // An array of features within the collection.
-	BBox     BoundingBox `json:"bbox,omitempty"` // Optional bounding box that encloses all features in the collection.
+	Type     ObjectType  `json:"type"`               // Specifies the type of GeoJSON object (e.g., "FeatureCollection").
+	Features *[]Feature  `json:"features,omitempty"` // An array of features within the collection, omitted when nil.
+	BBox     BoundingBox `json:"bbox,omitempty"`     // Optional bounding box that encloses all features in the collection.
 }
 
 // featureJSONOutput represents the output structure for a single GeoJSON feature.
 // It includes geometry, properties, an optional ID, and an optional bounding box.
 type featureJSONOutput struct {
-	Type       ObjectType  `json:"type"`                 // Specifies the type of GeoJSON object (e.g., "Feature").
-	Geometry   Geometry    `json:"geometry"`             // Contains the geometry of the GeoJSON feature.
-	Properties Properties  `json:"properties,omitempty"` // Describes additional properties of the GeoJSON feature.
-	ID         *ID         `json:"id,omitempty"`         // Optional identifier for the GeoJSON feature.
-	BBox       BoundingBox `json:"bbox,omitempty"`       // Optional bounding box that encloses the feature.
+	Type       ObjectType  `json:"type"`           // Specifies the type of GeoJSON object (e.g., "Feature").
+	Geometry   Geometry    `json:"geometry"`       // Contains the geometry of the GeoJSON feature.
+	Properties Properties  `json:"properties"`     // Describes additional properties of the GeoJSON feature, null when empty.
+	ID         *ID         `json:"id,omitempty"`   // Optional identifier for the GeoJSON feature.
+	BBox       BoundingBox `json:"bbox,omitempty"` // Optional bounding box that encloses the feature.
 }
 
 // geometryJSONInput represents the input structure for a GeoJSON geometry.
 // It captures the type, coordinates, optional bounding box, and sub-geometries when
 // handling collections.
 type geometryJSONInput struct {
-	Type        GeometryType     `json:"type"`        // Specifies the type of geometry (e.g., "Point", "Polygon").
-	Coordinates interface{}      `json:"coordinates"` // Contains the coordinates for the geometry.
-	Geometries  []GeometryObject `json:"geometries"`  // Contains sub-geometries if part of a geometry collection.
-	BBox        BoundingBox      `json:"bbox"`        // Optional bounding box that encloses the geometry.
+	Type        GeometryType      `json:"type"`        // Specifies the type of geometry (e.g., "Point", "Polygon").
+	Coordinates interface{}       `json:"coordinates"` // Contains the coordinates for the geometry.
+	Geometries  []json.RawMessage `json:"geometries"`  // Contains the raw sub-geometries if part of a geometry collection.
+	BBox        BoundingBox       `json:"bbox"`        // Optional bounding box that encloses the geometry.
 }
 
 // geometryJSONOutput represents the output structure for a GeoJSON geometry.
@@ -47,8 +60,44 @@ type geometryJSONOutput struct {
 }
 
 // geometryCollectionJSONOutput represents the output structure for a GeoJSON geometry collection.
-// It specifies the type and contains an array of geometries.
+// It specifies the type, contains an array of geometries and an optional bounding box.
 type geometryCollectionJSONOutput struct {
-	Type       GeometryType `json:"type"`       // Specifies the type of geometry collection (e.g., "GeometryCollection").
-	Geometries []Geometry   `json:"geometries"` // An array of geometries contained in the collection.
+	Type       GeometryType `json:"type"`           // Specifies the type of geometry collection (e.g., "GeometryCollection").
+	Geometries []Geometry   `json:"geometries"`     // An array of geometries contained in the collection.
+	BBox       BoundingBox  `json:"bbox,omitempty"` // Optional bounding box that encloses the collection.
+}
+
+// featurePropertiesJSONInput captures only the properties of a GeoJSON feature, decoded as a plain map
+// so that the options of the decoder apply to the values.
+type featurePropertiesJSONInput struct {
+	Properties map[string]interface{} `json:"properties"` // Describes additional properties of the GeoJSON feature.
+}
+
+// featureCollectionPropertiesJSONInput captures only the properties of the features of a GeoJSON feature collection.
+type featureCollectionPropertiesJSONInput struct {
+	Features []featurePropertiesJSONInput `json:"features"` // The properties of each feature, in order.
+}
+
+// topologyJSONInput represents the input structure of a TopoJSON topology.
+type topologyJSONInput struct {
+	Type      string                             `json:"type"`      // Specifies the type of the TopoJSON object, which must be "Topology".
+	Transform *topologyTransformJSONInput        `json:"transform"` // Optional transform of a quantized topology.
+	Arcs      [][][]float64                      `json:"arcs"`      // The arcs shared by the geometry objects.
+	Objects   map[string]topologyObjectJSONInput `json:"objects"`   // The geometry objects of the topology, by name.
+}
+
+// topologyTransformJSONInput represents the transform of a quantized TopoJSON topology.
+type topologyTransformJSONInput struct {
+	Scale     []float64 `json:"scale"`     // Scale of the quantized longitude and latitude.
+	Translate []float64 `json:"translate"` // Translation of the quantized longitude and latitude.
+}
+
+// topologyObjectJSONInput represents a TopoJSON geometry object.
+type topologyObjectJSONInput struct {
+	Type        string                    `json:"type"`        // Specifies the type of geometry, or null.
+	Arcs        json.RawMessage           `json:"arcs"`        // The indexes of the arcs of a line or polygon geometry.
+	Coordinates json.RawMessage           `json:"coordinates"` // The positions of a Point or MultiPoint.
+	Geometries  []topologyObjectJSONInput `json:"geometries"`  // Contains sub-geometries if part of a geometry collection.
+	ID          *ID                       `json:"id"`          // Optional identifier of the geometry object.
+	Properties  Properties                `json:"properties"`  // Optional properties of the geometry object.
 }
@@ -0,0 +1,192 @@
+package geojson
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+)
+
+// JSONSizer is an interface for objects that can estimate the size of their GeoJSON representation.
+type JSONSizer interface {
+	// EstimatedJSONSize returns the approximate number of bytes of the GeoJSON representation.
+	EstimatedJSONSize() int
+}
+
+const (
+	// jsonNullSize is the size of the JSON null literal.
+	jsonNullSize = len("null")
+	// jsonTrueSize is the size of the JSON true literal.
+	jsonTrueSize = len("true")
+	// jsonFalseSize is the size of the JSON false literal.
+	jsonFalseSize = len("false")
+)
+
+// estimateNumberSize returns the number of bytes used to encode a float64 as a JSON number.
+// It follows the formatting rules of encoding/json, switching to exponent notation
+// for very small and very large magnitudes.
+func estimateNumberSize(f float64) int {
+	var buf [32]byte
+
+	format := byte('f')
+	if abs := math.Abs(f); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+
+	return len(strconv.AppendFloat(buf[:0], f, format, -1, 64))
+}
+
+// estimateArraySize returns the size of a JSON array given the total size of its elements
+// and the number of elements, accounting for brackets and separators.
+func estimateArraySize(elementsSize, count int) int {
+	if count == 0 {
+		return len("[]")
+	}
+
+	return len("[]") + elementsSize + count - 1
+}
+
+// estimateMemberSize returns the size of an object member with the given key and value size,
+// including the quoted key and the colon.
+func estimateMemberSize(key string, valueSize int) int {
+	return len(key) + len(`"":`) + valueSize
+}
+
+// estimateObjectSize returns the size of a JSON object given the sizes of its members,
+// accounting for braces and separators.
+func estimateObjectSize(members ...int) int {
+	size := 0
+	for _, m := range members {
+		size += m
+	}
+
+	return estimateArraySize(size, len(members))
+}
+
+// estimateStringSize returns the size of a JSON string, ignoring escape sequences.
+func estimateStringSize(s string) int {
+	return len(s) + len(`""`)
+}
+
+// estimateCoordinatesSize returns the size of a JSON position array.
+func estimateCoordinatesSize(c Coordinates) int {
+	size := 0
+	for _, v := range c {
+		size += estimateNumberSize(v)
+	}
+
+	return estimateArraySize(size, len(c))
+}
+
+// estimateVerticesSize returns the size of a JSON array of positions.
+func estimateVerticesSize(v Vertices) int {
+	size := 0
+	for _, c := range v {
+		size += estimateCoordinatesSize(c)
+	}
+
+	return estimateArraySize(size, len(v))
+}
+
+// estimateSegmentsSize returns the size of a JSON array of position arrays.
+func estimateSegmentsSize(s Segments) int {
+	size := 0
+	for _, v := range s {
+		size += estimateVerticesSize(v)
+	}
+
+	return estimateArraySize(size, len(s))
+}
+
+// estimateLinearRingsSize returns the size of a JSON array of linear rings.
+func estimateLinearRingsSize(rings LinearRings) int {
+	size := 0
+	for _, r := range rings {
+		size += estimateVerticesSize(Vertices(r))
+	}
+
+	return estimateArraySize(size, len(rings))
+}
+
+// estimateBBoxMemberSize returns the size of the "bbox" member, including its leading separator.
+func estimateBBoxMemberSize(b BoundingBox) int {
+	if len(b) == 0 {
+		return 0
+	}
+
+	size := 0
+	for _, v := range b {
+		size += estimateNumberSize(v)
+	}
+
+	return len(",") + estimateMemberSize("bbox", estimateArraySize(size, len(b)))
+}
+
+// estimateGeometrySize returns the size of a GeoJSON geometry object with the given type,
+// coordinates size and serialized bounding box, if any.
+func estimateGeometrySize(t GeometryType, coordinatesSize int, b BoundingBox) int {
+	return estimateBBoxMemberSize(b) + estimateObjectSize(
+		estimateMemberSize("type", estimateStringSize(string(t))),
+		estimateMemberSize("coordinates", coordinatesSize),
+	)
+}
+
+// estimateGeometryValueSize returns the size of a geometry encoded as a member value, or null if it is nil.
+func estimateGeometryValueSize(g Geometry) int {
+	if g == nil {
+		return jsonNullSize
+	}
+
+	return g.EstimatedJSONSize()
+}
+
+// estimateValueSize returns the size of an arbitrary property value.
+// Values of types that are not produced by JSON decoding are measured by marshaling them.
+func estimateValueSize(v interface{}) int {
+	switch value := v.(type) {
+	case nil:
+		return jsonNullSize
+	case bool:
+		if value {
+			return jsonTrueSize
+		}
+		return jsonFalseSize
+	case string:
+		return estimateStringSize(value)
+	case float64:
+		return estimateNumberSize(value)
+	case float32:
+		return estimateNumberSize(float64(value))
+	case int:
+		return len(strconv.Itoa(value))
+	case int64:
+		return len(strconv.FormatInt(value, 10))
+	case json.Number:
+		return len(value)
+	case []interface{}:
+		size := 0
+		for _, e := range value {
+			size += estimateValueSize(e)
+		}
+		return estimateArraySize(size, len(value))
+	case map[string]interface{}:
+		return estimateMapSize(value)
+	case Properties:
+		return estimateMapSize(value)
+	default:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return 0
+		}
+		return len(data)
+	}
+}
+
+// estimateMapSize returns the size of a JSON object built from a map.
+func estimateMapSize(m map[string]interface{}) int {
+	members := make([]int, 0, len(m))
+	for k, v := range m {
+		members = append(members, estimateMemberSize(k, estimateValueSize(v)))
+	}
+
+	return estimateObjectSize(members...)
+}
@@ -0,0 +1,80 @@
+package geojson
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimatedJSONSize_Geometries(t *testing.T) {
+	polygon := MustPolygon(LinearRings{
+		*MustLinearRing(Vertices{{0, 0}, {10.5, 0}, {10.5, 10.25}, {0, 0}}),
+		*MustLinearRing(Vertices{{1, 1}, {2, 1}, {2, 2}, {1, 1}}),
+	})
+
+	bboxPoint := MustPoint([]float64{-73.985, 40.758, 12})
+	bboxPoint.SerializeBBox = true
+
+	tests := []struct {
+		name     string
+		geometry Geometry
+	}{
+		{"point", MustPoint([]float64{12.4924, 41.8902})},
+		{"point with altitude and bbox", bboxPoint},
+		{"line string", MustLineString(Vertices{{0, 0}, {1.123456, -2.5}, {179.999999, 89.1}})},
+		{"empty line string", &LineString{}},
+		{"multi point", NewMultiPointFromVertices(Vertices{{1, 2}, {3, 4, 5}})},
+		{"multi line string", MustMultiLineString(Segments{{{0, 0}, {1, 1}}, {{2, 2}, {3, 3}, {4, 4}}})},
+		{"polygon", polygon},
+		{"multi polygon", MustMultiPolygonFromRingSlice([]LinearRings{polygon.LinearRings(), polygon.LinearRings()})},
+		{"empty multi polygon", NewMultiPolygon()},
+		{"geometry collection", NewGeometryCollectionFromSlice([]Geometry{polygon, MustPoint([]float64{1e-7, 0})})},
+		{"empty geometry collection", NewGeometryCollection()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.geometry)
+			require.NoError(t, err)
+			assert.InDelta(t, len(data), tt.geometry.EstimatedJSONSize(), 2)
+		})
+	}
+}
+
+func TestEstimatedJSONSize_Features(t *testing.T) {
+	feature := NewFeatureBuilder().
+		SetGeometry(MustLineString(Vertices{{0, 0}, {1.5, 2.25}})).
+		SetProperties(Properties{
+			"name":   "road",
+			"lanes":  float64(2),
+			"oneway": true,
+			"tags":   []interface{}{"a", "b", nil},
+			"meta":   map[string]interface{}{"source": "survey", "year": 2024},
+		}).
+		SetID(*NewStringID("road-1")).
+		Build()
+	feature.SerializeBBox = true
+
+	tests := []struct {
+		name   string
+		object interface {
+			JSONSizer
+			json.Marshaler
+		}
+	}{
+		{"feature", &feature},
+		{"feature without geometry and properties", &Feature{ID: NewNumericID(42)}},
+		{"feature collection", &FeatureCollection{Features: []Feature{feature, {}}, SerializeBBox: true}},
+		{"empty feature collection", NewFeatureCollection()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.object.MarshalJSON()
+			require.NoError(t, err)
+			assert.Equal(t, len(data), tt.object.EstimatedJSONSize())
+		})
+	}
+}
@@ -3,6 +3,7 @@ package geojson
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 const (
@@ -18,7 +19,9 @@ var (
 // LineString represents a GeoJSON LineString geometry, defined by a series of vertices.
 type LineString struct {
 	vertices      Vertices // Vertices that define the LineString.
+	declaredBBox           // Bounding box declared in the decoded GeoJSON, if any.
 	SerializeBBox bool     // Whether to include a bounding box in the JSON serialization.
+	PreserveBBox  bool     // Whether to include the declared bounding box when SerializeBBox is false.
 }
 
 // Type returns the type of the geometry, which is always TypeLineString for LineString.
@@ -26,19 +29,111 @@ func (l *LineString) Type() GeometryType {
 	return TypeLineString
 }
 
+// AsGeometryObject wraps the LineString in a GeometryObject.
+func (l *LineString) AsGeometryObject() GeometryObject {
+	return FromGeometry(l)
+}
+
 // Vertices returns the Vertices of the LineString.
 func (l *LineString) Vertices() Vertices {
 	return l.vertices
 }
 
+// Add appends a copy of the coordinates to the vertices of the LineString.
+// It returns an error if the coordinates have an invalid size or an out-of-range longitude or latitude,
+// leaving the LineString unchanged.
+func (l *LineString) Add(c Coordinates) error {
+	coords, err := NewCoordinates(c)
+	if err != nil {
+		return err
+	}
+
+	l.vertices = append(l.vertices, *coords)
+	return nil
+}
+
 // BoundingBox calculates the bounding box for the LineString.
 func (l *LineString) BoundingBox() BoundingBox {
 	return bbox(l.Vertices())
 }
 
+// EstimatedJSONSize returns the approximate size in bytes of the GeoJSON representation of the LineString.
+func (l *LineString) EstimatedJSONSize() int {
+	return estimateGeometrySize(l.Type(), estimateVerticesSize(l.vertices), l.serializedBBox(l, l.SerializeBBox, l.PreserveBBox))
+}
+
+// Length returns the length of the LineString in meters, as the sum of the great-circle distances
+// between consecutive vertices computed with the haversine formula. Altitude is ignored, and a LineString
+// with fewer than two vertices has a length of 0.
+func (l *LineString) Length() float64 {
+	return verticesLength(l.vertices)
+}
+
+// ClosestPoint returns the position of the LineString closest to the given coordinates and its great-circle
+// distance in meters. The closest position of each segment is found by projecting the coordinates onto it,
+// treating longitude and latitude as planar coordinates and clamping to the segment ends, and altitude is
+// interpolated when both ends have one. The distance is then computed with the haversine formula.
+// The planar projection may pick a slightly wrong position on long segments or at high latitudes, where
+// degrees of longitude shrink, so the distance can be overestimated there. A LineString without vertices
+// returns nil and +Inf.
+func (l *LineString) ClosestPoint(to Coordinates) (Coordinates, float64) {
+	closest, distance := closestOnEdges(verticesEdges(l.vertices), l.vertices, to)
+	return slices.Clone(closest), distance
+}
+
+// Smooth returns a new LineString smoothed by applying Chaikin's corner-cutting algorithm the given number
+// of times. Each iteration replaces every segment with two positions at 1/4 and 3/4 of its length, while the
+// first and last positions are preserved. Altitude values are interpolated when present on both ends.
+// Since each iteration nearly doubles the number of vertices, a few iterations are usually enough.
+// Non-positive iterations return a copy of the LineString.
+func (l *LineString) Smooth(iterations int) *LineString {
+	vertices := cloneVertices(l.vertices)
+
+	for n := 0; n < iterations && len(vertices) > LineStringMinimumSize; n++ {
+		smoothed := make(Vertices, 0, 2*len(vertices))
+		smoothed = append(smoothed, vertices[0])
+		for i := 0; i < len(vertices)-1; i++ {
+			a, b := vertices[i], vertices[i+1]
+			smoothed = append(smoothed, interpolateLinear(a, b, 0.25), interpolateLinear(a, b, 0.75))
+		}
+		vertices = append(smoothed, vertices[len(vertices)-1])
+	}
+
+	return &LineString{vertices: vertices}
+}
+
+// Simplify returns a new LineString simplified with the Ramer-Douglas-Peucker algorithm.
+// Vertices whose perpendicular distance from the simplified line is within tolerance, expressed in degrees
+// of longitude and latitude, are dropped. The first and last vertices are always kept, so the result never
+// has fewer than LineStringMinimumSize vertices.
+func (l *LineString) Simplify(tolerance float64) *LineString {
+	return &LineString{vertices: simplifyVertices(l.vertices, tolerance)}
+}
+
+// Reverse reverses the order of the vertices of the LineString in place.
+func (l *LineString) Reverse() {
+	slices.Reverse(l.vertices)
+}
+
+// Densify returns a new LineString with positions linearly interpolated along every segment longer than
+// maxSegmentLength, expressed in degrees of longitude and latitude, so that no segment exceeds it.
+// Altitude is interpolated when present on both ends of a segment. Existing vertices are all kept,
+// and a non-positive maxSegmentLength returns a copy of the LineString.
+func (l *LineString) Densify(maxSegmentLength float64) *LineString {
+	return &LineString{vertices: densifyVertices(l.vertices, maxSegmentLength)}
+}
+
+// Subsample returns a new LineString keeping every nth vertex, starting from the first, and the last vertex,
+// so that the result has at least LineStringMinimumSize vertices when the LineString has. Unlike Simplify,
+// the number of vertices kept depends only on n, not on the shape of the line. A value of n lower than 2
+// returns a copy of the LineString.
+func (l *LineString) Subsample(n int) *LineString {
+	return &LineString{vertices: subsampleVertices(l.vertices, n)}
+}
+
 // buildCoordinates constructs the LineString's vertices from the provided raw data.
 // Returns an error if the input is invalid or the number of coordinates is less than the minimum required.
-func (l *LineString) buildCoordinates(v interface{}) error {
+func (l *LineString) buildCoordinates(v interface{}, opts decodeOptions) error {
 	rawSlice, ok := v.([]interface{})
 	if !ok {
 		return ErrInvalidCoordinates
@@ -51,7 +146,7 @@ func (l *LineString) buildCoordinates(v interface{}) error {
 	vertices := make(Vertices, len(rawSlice))
 	for i, s := range rawSlice {
 		p := Point{}
-		if err := p.buildCoordinates(s); err != nil {
+		if err := p.buildCoordinates(s, opts); err != nil {
 			return err
 		}
 
@@ -76,9 +171,7 @@ func (l *LineString) MarshalJSON() ([]byte, error) {
 		Coordinates: vertices,
 	}
 
-	if l.SerializeBBox {
-		out.BBox = l.BoundingBox()
-	}
+	out.BBox = l.serializedBBox(l, l.SerializeBBox, l.PreserveBBox)
 
 	return json.Marshal(&out)
 }
@@ -86,20 +179,7 @@ func (l *LineString) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON deserializes the GeoJSON data into a LineString.
 // Returns an error if the data is invalid or the type does not match LineString.
 func (l *LineString) UnmarshalJSON(data []byte) error {
-	gw := &GeometryObject{}
-
-	if err := gw.UnmarshalJSON(data); err != nil {
-		return fmt.Errorf("failed to unmarshal %s: %w", l.Type(), err)
-	}
-
-	g, ok := gw.geometry.(*LineString)
-	if !ok {
-		return ErrInvalidTypeField
-	}
-
-	l.vertices = g.vertices
-
-	return nil
+	return decodeGeometryInto(l, data, decodeOptions{})
 }
 
 // NewLineString creates a new LineString from the provided vertices.
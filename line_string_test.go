@@ -1,6 +1,7 @@
 package geojson
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -105,7 +106,7 @@ func TestLineString_buildCoordinates(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			line := &LineString{}
-			err := line.buildCoordinates(tc.input)
+			err := line.buildCoordinates(tc.input, decodeOptions{})
 			if tc.expectErr != nil {
 				assert.ErrorIs(t, err, tc.expectErr)
 			} else {
@@ -261,3 +262,242 @@ func TestMustLineStringFromVertices(t *testing.T) {
 		})
 	}
 }
+
+func TestLineString_Smooth(t *testing.T) {
+	tests := []struct {
+		name       string
+		lineString *LineString
+		iterations int
+		expected   Vertices
+	}{
+		{
+			name:       "single iteration",
+			lineString: MustLineString(Vertices{{0, 0}, {4, 0}, {4, 4}}),
+			iterations: 1,
+			expected:   Vertices{{0, 0}, {1, 0}, {3, 0}, {4, 1}, {4, 3}, {4, 4}},
+		},
+		{
+			name:       "altitude is interpolated",
+			lineString: MustLineString(Vertices{{0, 0, 0}, {4, 0, 40}, {4, 4, 80}}),
+			iterations: 1,
+			expected:   Vertices{{0, 0, 0}, {1, 0, 10}, {3, 0, 30}, {4, 1, 50}, {4, 3, 70}, {4, 4, 80}},
+		},
+		{
+			name:       "straight segment is unchanged",
+			lineString: MustLineString(Vertices{{0, 0}, {4, 4}}),
+			iterations: 3,
+			expected:   Vertices{{0, 0}, {4, 4}},
+		},
+		{
+			name:       "no iterations",
+			lineString: MustLineString(Vertices{{0, 0}, {4, 0}, {4, 4}}),
+			iterations: 0,
+			expected:   Vertices{{0, 0}, {4, 0}, {4, 4}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.lineString.Smooth(tt.iterations).Vertices())
+		})
+	}
+
+	t.Run("vertex count and endpoints", func(t *testing.T) {
+		l := MustLineString(Vertices{{0, 0}, {4, 0}, {4, 4}, {8, 4}})
+		smoothed := l.Smooth(3)
+
+		v := smoothed.Vertices()
+		assert.Len(t, v, 32)
+		assert.Equal(t, Coordinates{0, 0}, v[0])
+		assert.Equal(t, Coordinates{8, 4}, v[len(v)-1])
+		assert.Equal(t, Vertices{{0, 0}, {4, 0}, {4, 4}, {8, 4}}, l.Vertices())
+	})
+}
+
+func TestLineString_Simplify(t *testing.T) {
+	tests := []struct {
+		name       string
+		lineString *LineString
+		tolerance  float64
+		expected   Vertices
+	}{
+		{
+			name:       "drops nearly collinear vertices",
+			lineString: MustLineString(Vertices{{0, 0}, {1, 0.05}, {2, -0.05}, {3, 5}, {4, 6}, {5, 7}, {6, 8.1}, {7, 9}}),
+			tolerance:  0.5,
+			expected:   Vertices{{0, 0}, {2, -0.05}, {3, 5}, {7, 9}},
+		},
+		{
+			name:       "collapses to endpoints",
+			lineString: MustLineString(Vertices{{0, 0}, {1, 0.1}, {2, 0}}),
+			tolerance:  1,
+			expected:   Vertices{{0, 0}, {2, 0}},
+		},
+		{
+			name:       "zero tolerance keeps non-collinear vertices",
+			lineString: MustLineString(Vertices{{0, 0}, {1, 0}, {2, 0}, {2, 1}}),
+			tolerance:  0,
+			expected:   Vertices{{0, 0}, {2, 0}, {2, 1}},
+		},
+		{
+			name:       "two vertices",
+			lineString: MustLineString(Vertices{{0, 0}, {1, 1}}),
+			tolerance:  10,
+			expected:   Vertices{{0, 0}, {1, 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.lineString.Simplify(tt.tolerance).Vertices())
+		})
+	}
+}
+
+func TestLineString_Reverse(t *testing.T) {
+	l := MustLineString(Vertices{{0, 0}, {1, 1}, {2, 0, 5}})
+	l.Reverse()
+	assert.Equal(t, Vertices{{2, 0, 5}, {1, 1}, {0, 0}}, l.Vertices())
+
+	data, err := l.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"LineString","coordinates":[[2,0,5],[1,1],[0,0]]}`, string(data))
+}
+
+func TestLineString_Subsample(t *testing.T) {
+	line := MustLineString(Vertices{{0, 0}, {1, 0}, {2, 0}, {3, 0}, {4, 0}, {5, 0}, {6, 0}})
+
+	tests := []struct {
+		name     string
+		n        int
+		expected Vertices
+	}{
+		{"every second vertex", 2, Vertices{{0, 0}, {2, 0}, {4, 0}, {6, 0}}},
+		{"last vertex is kept", 4, Vertices{{0, 0}, {4, 0}, {6, 0}}},
+		{"step longer than the line", 10, Vertices{{0, 0}, {6, 0}}},
+		{"n of 1", 1, line.Vertices()},
+		{"non-positive n", 0, line.Vertices()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, line.Subsample(tt.n).Vertices())
+		})
+	}
+
+	subsampled := line.Subsample(1)
+	subsampled.Vertices()[0][0] = 9
+	assert.Equal(t, Coordinates{0, 0}, line.Vertices()[0], "the result should be a copy")
+}
+
+func TestLineString_Densify(t *testing.T) {
+	tests := []struct {
+		name             string
+		lineString       *LineString
+		maxSegmentLength float64
+		expected         Vertices
+	}{
+		{
+			name:             "splits long segments evenly",
+			lineString:       MustLineString(Vertices{{0, 0}, {3, 0}, {3, 0.5}}),
+			maxSegmentLength: 1,
+			expected:         Vertices{{0, 0}, {1, 0}, {2, 0}, {3, 0}, {3, 0.5}},
+		},
+		{
+			name:             "interpolates altitude",
+			lineString:       MustLineString(Vertices{{0, 0, 100}, {0, 2, 200}}),
+			maxSegmentLength: 0.75,
+			expected:         Vertices{{0, 0, 100}, {0, 2.0 / 3, 100 + 100.0/3}, {0, 4.0 / 3, 100 + 200.0/3}, {0, 2, 200}},
+		},
+		{
+			name:             "non-positive length",
+			lineString:       MustLineString(Vertices{{0, 0}, {3, 0}}),
+			maxSegmentLength: 0,
+			expected:         Vertices{{0, 0}, {3, 0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := cloneVertices(tt.lineString.Vertices())
+			densified := tt.lineString.Densify(tt.maxSegmentLength)
+
+			assert.Len(t, densified.Vertices(), len(tt.expected))
+			for i, c := range densified.Vertices() {
+				assert.InDeltaSlice(t, tt.expected[i], c, 1e-9)
+			}
+			assert.Equal(t, original, tt.lineString.Vertices())
+		})
+	}
+}
+
+func TestLineString_Add(t *testing.T) {
+	var l LineString
+	require.NoError(t, l.Add(Coordinates{1, 2}))
+
+	c := Coordinates{3, 4, 5}
+	require.NoError(t, l.Add(c))
+	c[0] = 99
+
+	assert.ErrorIs(t, l.Add(Coordinates{0, 91}), ErrLatitudeRange)
+	assert.ErrorIs(t, l.Add(Coordinates{1}), ErrCoordinatesSize)
+	assert.Equal(t, Vertices{{1, 2}, {3, 4, 5}}, l.Vertices())
+}
+
+func TestLineString_ClosestPoint(t *testing.T) {
+	l := MustLineString(Vertices{{0, 0, 10}, {2, 0, 30}, {2, 2}})
+
+	tests := []struct {
+		name     string
+		to       Coordinates
+		expected Coordinates
+	}{
+		{"projected on the first segment", Coordinates{1, 0.5}, Coordinates{1, 0, 20}},
+		{"projected on the second segment", Coordinates{3, 1}, Coordinates{2, 1}},
+		{"clamped to the start", Coordinates{-1, -1}, Coordinates{0, 0, 10}},
+		{"on a vertex", Coordinates{2, 0}, Coordinates{2, 0, 30}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			closest, distance := l.ClosestPoint(tt.to)
+			assert.Equal(t, tt.expected, closest)
+			assert.InDelta(t, closest.Distance(tt.to), distance, 1e-9)
+		})
+	}
+
+	// The returned position is a copy.
+	closest, _ := l.ClosestPoint(Coordinates{-1, 0})
+	closest[0] = 99
+	assert.Equal(t, Vertices{{0, 0, 10}, {2, 0, 30}, {2, 2}}, l.Vertices())
+
+	closest, distance := (&LineString{vertices: Vertices{{1, 1}, {1, 1}}}).ClosestPoint(Coordinates{1, 2})
+	assert.Equal(t, Coordinates{1, 1}, closest)
+	assert.InDelta(t, 111195, distance, 1)
+
+	closest, distance = (&LineString{}).ClosestPoint(Coordinates{0, 0})
+	assert.Nil(t, closest)
+	assert.True(t, math.IsInf(distance, 1))
+}
+
+func TestLineString_Length(t *testing.T) {
+	degree := EarthMeanRadius * math.Pi / 180
+
+	tests := []struct {
+		name     string
+		vertices Vertices
+		expected float64
+	}{
+		{"along the equator", Vertices{{0, 0}, {1, 0}, {3, 0}}, 3 * degree},
+		{"altitude is ignored", Vertices{{0, 0, 0}, {0, 1, 1000}}, degree},
+		{"single vertex", Vertices{{0, 0}}, 0},
+		{"no vertices", nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &LineString{vertices: tt.vertices}
+			assert.InDelta(t, tt.expected, l.Length(), 1e-6)
+		})
+	}
+}
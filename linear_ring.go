@@ -31,6 +31,30 @@ func (lr *LinearRing) IsValid() bool {
 	return lr.HasValidSize() && lr.IsClosed()
 }
 
+// IsSimple reports whether the LinearRing is valid and does not intersect itself: edges that are not
+// adjacent must not cross or touch, and adjacent edges must share only their common position, which rules
+// out rings doubling back over themselves. Longitude and latitude are treated as planar coordinates,
+// and repeated consecutive positions are ignored. Every pair of edges is tested, so the cost is O(n²)
+// in the number of positions.
+func (lr *LinearRing) IsSimple() bool {
+	if !lr.IsValid() {
+		return false
+	}
+
+	edges := verticesEdges(Vertices(*lr))
+	last := len(edges) - 1
+	for i := 0; i < last; i++ {
+		for j := i + 1; j <= last; j++ {
+			adjacent := j == i+1 || (i == 0 && j == last)
+			if adjacent && edges[i].overlaps(edges[j]) || !adjacent && edges[i].intersects(edges[j]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 // HasValidSize verifies if the LinearRing has the minimum required number
 // of coordinates.
 func (lr *LinearRing) HasValidSize() bool {
@@ -67,6 +91,14 @@ func (lr *LinearRing) Area() float64 {
 	return math.Abs(signedArea(*lr))
 }
 
+// GeodesicArea computes the area enclosed by the LinearRing on a spherical model of the Earth,
+// in square meters, using EarthMeanRadius. Unlike Area, the result is meaningful for large rings.
+// Longitude differences are normalized to [-180, 180], so rings crossing the antimeridian are measured
+// along the shorter way around. Altitude values are ignored.
+func (lr *LinearRing) GeodesicArea() float64 {
+	return math.Abs(signedSphericalArea(*lr))
+}
+
 // EnsureOrientation ensures the LinearRing vertices are ordered in the desired direction.
 // If the current order is different from the expected order, it reverses the vertices.
 // The parameter shouldBeCounterClockwise determines the desired orientation:
@@ -107,6 +139,121 @@ func MustLinearRing(vertices Vertices) *LinearRing {
 	return lr
 }
 
+// simplifyRing returns a copy of the closed ring simplified with the Ramer-Douglas-Peucker algorithm,
+// keeping at least LinearRingMinimumSize positions. The ring is split at the position farthest from its
+// first one, so that both halves are simplified against a proper segment.
+func simplifyRing(ring LinearRing, tolerance float64) LinearRing {
+	v := Vertices(ring)
+	if len(v) <= LinearRingMinimumSize {
+		return LinearRing(cloneVertices(v))
+	}
+
+	far, maxDistance := 0, -1.0
+	for i := 1; i < len(v)-1; i++ {
+		if d := planarDistanceToSegment(v[i], v[0], v[0]); d > maxDistance {
+			far, maxDistance = i, d
+		}
+	}
+
+	out := simplifyVertices(v[:far+1], tolerance)
+	out = append(out, simplifyVertices(v[far:], tolerance)[1:]...)
+
+	// When both halves collapse, keep the position farthest from the segment joining the two ends,
+	// so that the ring still has three distinct positions.
+	if len(out) < LinearRingMinimumSize {
+		third, maxDistance := 0, -1.0
+		for i := 1; i < len(v)-1; i++ {
+			if d := planarDistanceToSegment(v[i], v[0], v[far]); i != far && d > maxDistance {
+				third, maxDistance = i, d
+			}
+		}
+
+		if third < far {
+			out = Vertices{out[0], slices.Clone(v[third]), out[1], out[2]}
+		} else {
+			out = Vertices{out[0], out[1], slices.Clone(v[third]), out[2]}
+		}
+	}
+
+	return LinearRing(out)
+}
+
+// ringCollapses reports whether simplifying the closed ring with the given tolerance reduces it to a segment,
+// which happens when no position lies farther than the tolerance from the segment joining the first position
+// to the one farthest from it. simplifyRing keeps a third position for such rings so that they stay valid.
+func ringCollapses(ring LinearRing, tolerance float64) bool {
+	v := Vertices(ring)
+
+	far, maxDistance := 0, -1.0
+	for i := 1; i < len(v)-1; i++ {
+		if d := planarDistanceToSegment(v[i], v[0], v[0]); d > maxDistance {
+			far, maxDistance = i, d
+		}
+	}
+
+	for i := 1; i < len(v)-1; i++ {
+		if planarDistanceToSegment(v[i], v[0], v[far]) > tolerance {
+			return false
+		}
+	}
+	return true
+}
+
+// subsampleRing returns a copy of the ring keeping every nth position, starting from the first, closed again
+// with a copy of the first position. The step is reduced when needed so that at least 3 positions are kept
+// before the closing one. A value of n lower than 2 keeps every position.
+func subsampleRing(ring LinearRing, n int) LinearRing {
+	open := Vertices(ring[:max(len(ring)-1, 0)])
+	n = min(n, len(open)/(LinearRingMinimumSize-1))
+	if n <= 1 {
+		return LinearRing(cloneVertices(Vertices(ring)))
+	}
+
+	out := make(LinearRing, 0, (len(open)+n-1)/n+1)
+	for i := 0; i < len(open); i += n {
+		out = append(out, slices.Clone(open[i]))
+	}
+
+	return append(out, slices.Clone(out[0]))
+}
+
+// Position of a point relative to a ring, as returned by locatePoint.
+const (
+	ringExterior = -1
+	ringBoundary = 0
+	ringInterior = 1
+)
+
+// locatePoint reports whether the position lies in the interior, on the boundary, or in the exterior of the ring,
+// using the ray-casting algorithm on longitude and latitude. The result does not depend on the ring orientation.
+func locatePoint(ring LinearRing, c Coordinates) int {
+	x, y := c[idxCoordsLng], c[idxCoordsLat]
+
+	inside := false
+	for i := 0; i < len(ring)-1; i++ {
+		x1, y1 := ring[i][idxCoordsLng], ring[i][idxCoordsLat]
+		x2, y2 := ring[i+1][idxCoordsLng], ring[i+1][idxCoordsLat]
+
+		// A position collinear with the edge and within its extent lies on the boundary.
+		if (x2-x1)*(y-y1) == (x-x1)*(y2-y1) &&
+			x >= math.Min(x1, x2) && x <= math.Max(x1, x2) &&
+			y >= math.Min(y1, y2) && y <= math.Max(y1, y2) {
+			return ringBoundary
+		}
+
+		// Count the edges crossed by a horizontal ray cast towards increasing longitude.
+		if (y1 > y) != (y2 > y) && x < x1+(y-y1)*(x2-x1)/(y2-y1) {
+			inside = !inside
+		}
+	}
+
+	if inside {
+		return ringInterior
+	}
+
+	return ringExterior
+}
+
 // signedArea calculates the signed area of a LinearRing using the shoelace formula.
 // The formula is: Area = 0.5 * Σ (x(i) * y(i+1) - x(i+1) * y(i))
 // A positive result indicates that the vertices are ordered counterclockwise,
@@ -121,3 +268,27 @@ func signedArea(ring LinearRing) float64 {
 
 	return v * 0.5
 }
+
+// signedSphericalArea calculates the signed area of a LinearRing on a sphere of radius EarthMeanRadius,
+// in square meters, from the spherical excess of each edge:
+// Area = -R² / 2 * Σ (λ(i+1) - λ(i)) * (2 + sin φ(i) + sin φ(i+1))
+// The sum is negated so that, as with signedArea, counterclockwise rings have a positive area.
+func signedSphericalArea(ring LinearRing) float64 {
+	var v float64
+	for i := 0; i < len(ring)-1; i++ {
+		dLng := ring[i+1][idxCoordsLng] - ring[i][idxCoordsLng]
+		// Take the shorter way around, so that edges crossing the antimeridian keep their actual width.
+		switch {
+		case dLng > 180:
+			dLng -= 360
+		case dLng < -180:
+			dLng += 360
+		}
+
+		lat1 := degreesToRadians(ring[i][idxCoordsLat])
+		lat2 := degreesToRadians(ring[i+1][idxCoordsLat])
+		v += degreesToRadians(dLng) * (2 + math.Sin(lat1) + math.Sin(lat2))
+	}
+
+	return -v * EarthMeanRadius * EarthMeanRadius / 2
+}
@@ -62,6 +62,32 @@ func TestLinearRing_IsClosed(t *testing.T) {
 	}
 }
 
+func TestLinearRing_IsSimple(t *testing.T) {
+	tests := []struct {
+		name     string
+		lr       LinearRing
+		expected bool
+	}{
+		{"square", LinearRing{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}, true},
+		{"triangle", LinearRing{{0, 0}, {2, 0}, {1, 2}, {0, 0}}, true},
+		{"concave", LinearRing{{1, 1}, {4, 1}, {4, 5}, {3, 3}, {2, 4}, {1, 5}, {1, 1}}, true},
+		{"repeated position", LinearRing{{0, 0}, {2, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}, true},
+		{"collinear positions", LinearRing{{0, 0}, {1, 0}, {2, 0}, {2, 2}, {0, 0}}, true},
+		{"bowtie", LinearRing{{0, 0}, {4, 4}, {4, 0}, {0, 4}, {0, 0}}, false},
+		{"touching vertex", LinearRing{{0, 0}, {4, 0}, {4, 4}, {2, 0}, {0, 4}, {0, 0}}, false},
+		{"doubling back", LinearRing{{0, 0}, {4, 0}, {2, 0}, {2, 2}, {0, 0}}, false},
+		{"collinear across the start", LinearRing{{2, 0}, {4, 0}, {4, 4}, {0, 0}, {2, 0}}, true},
+		{"doubling back across the start", LinearRing{{2, 0}, {1, 0}, {3, 3}, {0, 0}, {2, 0}}, false},
+		{"zero area", LinearRing{{0, 0}, {1, 1}, {2, 2}, {0, 0}}, false},
+		{"not closed", LinearRing{{0, 0}, {2, 0}, {2, 2}, {0, 2}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.lr.IsSimple())
+		})
+	}
+}
+
 func TestNewLinearRing(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -197,3 +223,31 @@ func TestLinearRing_Area(t *testing.T) {
 		})
 	}
 }
+
+func TestLinearRing_GeodesicArea(t *testing.T) {
+	tests := []struct {
+		name string
+		lr   *LinearRing
+		want float64
+	}{
+		{"one degree cell at the equator", MustLinearRing(Vertices{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}), 12363718145.18},
+		{"clockwise ring", MustLinearRing(Vertices{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}), 12363718145.18},
+		{"crossing the antimeridian", MustLinearRing(Vertices{{179, -10}, {-179, -10}, {-179, 10}, {179, 10}, {179, -10}}), 492066726227.87},
+		{"same cell away from the antimeridian", MustLinearRing(Vertices{{0, -10}, {2, -10}, {2, 10}, {0, 10}, {0, -10}}), 492066726227.87},
+		{"zero area (line)", MustLinearRing(Vertices{{0, 0}, {1, 1}, {2, 2}, {1, 1}, {0, 0}}), 0.0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, tt.lr.GeodesicArea(), tt.want*1e-3+1)
+		})
+	}
+
+	t.Run("sign follows signedArea", func(t *testing.T) {
+		ccw := *MustLinearRing(Vertices{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}})
+		cw := *MustLinearRing(Vertices{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}})
+		assert.Positive(t, signedArea(ccw))
+		assert.Positive(t, signedSphericalArea(ccw))
+		assert.Negative(t, signedArea(cw))
+		assert.Negative(t, signedSphericalArea(cw))
+	})
+}
@@ -0,0 +1,345 @@
+package geojson
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidFormattedNumber is returned when a CoordinateFormatter produces a value that is not a JSON number.
+	ErrInvalidFormattedNumber = errors.New("coordinate formatter returned an invalid JSON number")
+)
+
+const (
+	// RecommendedPrecision is the number of decimal digits suggested by RFC 7946 for coordinate values,
+	// which corresponds to about 10 centimeters.
+	RecommendedPrecision = 6
+)
+
+const (
+	// memberCoordinates is the name of the member holding the positions of a geometry.
+	memberCoordinates = "coordinates"
+	// memberBBox is the name of the member holding a bounding box.
+	memberBBox = "bbox"
+)
+
+var (
+	// structuralMembers lists the names of the members of GeoJSON objects whose values are rewritten,
+	// either positions and bounding boxes or the GeoJSON objects they contain.
+	structuralMembers = []string{memberCoordinates, memberBBox, "geometry", "features", "geometries"}
+)
+
+// MarshalOptions configures how GeoJSON objects are encoded by MarshalOptions.Marshal.
+// The zero value produces the same output as json.Marshal.
+type MarshalOptions struct {
+	// CoordinateFormatter formats each coordinate and bounding box value.
+	// It must return a valid JSON number. When nil, the default encoding of encoding/json is used.
+	CoordinateFormatter func(float64) string
+
+	// DefaultAltitude, when set, is appended to every 2D position, so that geometries are emitted as 3D
+	// without being modified. 2D bounding boxes are extended with the same altitude as minimum and maximum.
+	DefaultAltitude *float64
+
+	// Prefix and Indent, when either is set, produce indented output as json.MarshalIndent does: each element
+	// begins on a new line starting with Prefix followed by one or more copies of Indent according to the
+	// nesting. Coordinate values are formatted before indenting.
+	Prefix string
+	Indent string
+}
+
+// MarshalIndent encodes v as GeoJSON like json.MarshalIndent, with each element on a new line starting with
+// prefix followed by copies of indent according to the nesting. Use MarshalOptions to combine indentation
+// with coordinate formatting.
+func MarshalIndent(v interface{}, prefix, indent string) ([]byte, error) {
+	o := MarshalOptions{Prefix: prefix, Indent: indent}
+	return o.Marshal(v)
+}
+
+// FixedPrecisionFormatter returns a CoordinateFormatter that writes values with exactly
+// the given number of decimal digits, such as 12.50000 for 5 decimals.
+func FixedPrecisionFormatter(decimals int) func(float64) string {
+	return func(v float64) string {
+		return strconv.FormatFloat(v, 'f', max(decimals, 0), 64)
+	}
+}
+
+// PrecisionFormatter returns a CoordinateFormatter that rounds values to the given number of decimal digits
+// and writes them without trailing zeros, such as 12.5 for 12.50001 and 6 decimals.
+// Use RecommendedPrecision to follow the RFC 7946 recommendation.
+func PrecisionFormatter(decimals int) func(float64) string {
+	return func(v float64) string {
+		s := strconv.FormatFloat(v, 'f', max(decimals, 0), 64)
+		if strings.IndexByte(s, '.') >= 0 {
+			s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+		}
+		if s == "-0" {
+			s = "0"
+		}
+		return s
+	}
+}
+
+// SignificantDigitsFormatter returns a CoordinateFormatter that rounds values to the given number
+// of significant digits and writes them without an exponent, such as 12300 for 12345.678 and 3 digits.
+func SignificantDigitsFormatter(digits int) func(float64) string {
+	return func(v float64) string {
+		rounded, _ := strconv.ParseFloat(strconv.FormatFloat(v, 'g', max(digits, 1), 64), 64)
+		return strconv.FormatFloat(rounded, 'f', -1, 64)
+	}
+}
+
+// Marshal encodes v, typically a Geometry, Feature, or FeatureCollection, as GeoJSON applying the options.
+// Only the values of the "coordinates" and "bbox" members of GeoJSON objects, encoded with a known "type"
+// as their first member, are affected. Properties and foreign members are left untouched, except for the
+// indentation, which applies to the whole output.
+func (o *MarshalOptions) Marshal(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.CoordinateFormatter != nil || o.DefaultAltitude != nil {
+		if data, err = o.rewrite(data); err != nil {
+			return nil, err
+		}
+	}
+
+	if o.Prefix == "" && o.Indent == "" {
+		return data, nil
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, o.Prefix, o.Indent); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// marshalFrame tracks the state of an object or array while rewriting encoded GeoJSON.
+type marshalFrame struct {
+	object      bool   // object reports whether the container is an object rather than an array.
+	count       int    // count is the number of values written in the container.
+	key         string // key is the name of the member whose value is expected next, for objects.
+	expectKey   bool   // expectKey reports whether the next token of an object is a member name.
+	numbers     int    // numbers is the number of values written in the container that are numbers.
+	coordinates bool   // coordinates reports whether the numbers in the container are coordinate values.
+	bbox        bool   // bbox reports whether the container is a bounding box.
+	bboxSplit   int    // bboxSplit is the output offset between the minimum and maximum values of a bounding box.
+	geoJSON     bool   // geoJSON reports whether the container is an object whose first member is a GeoJSON type.
+	opaque      bool   // opaque reports whether the container is part of the properties or a foreign member of a GeoJSON object.
+}
+
+// rewrite re-encodes compact JSON data, formatting the numbers found in coordinates and bounding boxes
+// and padding 2D positions with the default altitude. Member order and all other values are preserved.
+func (o *MarshalOptions) rewrite(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var buf bytes.Buffer
+	buf.Grow(len(data))
+
+	var stack []*marshalFrame
+	for {
+		token, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var parent *marshalFrame
+		if len(stack) > 0 {
+			parent = stack[len(stack)-1]
+		}
+
+		// Member names are written along with the separators that precede them.
+		if parent != nil && parent.object && parent.expectKey {
+			if delim, ok := token.(json.Delim); !ok || delim != '}' {
+				if parent.count > 0 {
+					buf.WriteByte(',')
+				}
+				parent.key, _ = token.(string)
+				parent.expectKey = false
+				if err := writeJSONString(&buf, parent.key); err != nil {
+					return nil, err
+				}
+				buf.WriteByte(':')
+				continue
+			}
+		}
+
+		if delim, ok := token.(json.Delim); ok && (delim == '}' || delim == ']') {
+			if err := o.padAltitude(&buf, parent); err != nil {
+				return nil, err
+			}
+			buf.WriteByte(byte(delim))
+			stack = stack[:len(stack)-1]
+			endMarshalValue(stack)
+			continue
+		}
+
+		if parent != nil && !parent.object && parent.count > 0 {
+			buf.WriteByte(',')
+		}
+
+		coordinates, bbox, opaque := false, false, false
+		if parent != nil {
+			opaque = parent.opaque
+			if parent.object {
+				opaque = opaque || (parent.geoJSON && !slices.Contains(structuralMembers, parent.key))
+				member := parent.geoJSON && !opaque
+				coordinates = member && (parent.key == memberCoordinates || parent.key == memberBBox)
+				bbox = member && parent.key == memberBBox
+			} else {
+				coordinates = parent.coordinates
+			}
+
+			// Remember where the maximum values of a 2D bounding box start, to insert the minimum altitude.
+			if parent.bbox && parent.count == bboxSize2D/2 {
+				parent.bboxSplit = buf.Len()
+			}
+		}
+
+		switch value := token.(type) {
+		case json.Delim:
+			buf.WriteByte(byte(value))
+			stack = append(stack, &marshalFrame{
+				object:      value == '{',
+				expectKey:   value == '{',
+				coordinates: coordinates,
+				bbox:        bbox && value == '[',
+				opaque:      opaque,
+			})
+			continue
+		case json.Number:
+			if err := o.writeNumber(&buf, value, coordinates); err != nil {
+				return nil, err
+			}
+			if parent != nil {
+				parent.numbers++
+			}
+		case string:
+			if err := writeJSONString(&buf, value); err != nil {
+				return nil, err
+			}
+			if parent != nil && parent.object && parent.count == 0 && parent.key == "type" && !opaque {
+				parent.geoJSON = isGeoJSONType(value)
+			}
+		case bool:
+			buf.WriteString(strconv.FormatBool(value))
+		case nil:
+			buf.WriteString("null")
+		}
+
+		endMarshalValue(stack)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeNumber writes a number, formatting it with the CoordinateFormatter if it is a coordinate value.
+func (o *MarshalOptions) writeNumber(buf *bytes.Buffer, n json.Number, coordinates bool) error {
+	if !coordinates || o.CoordinateFormatter == nil {
+		buf.WriteString(n.String())
+		return nil
+	}
+
+	f, err := n.Float64()
+	if err != nil {
+		return err
+	}
+
+	return o.writeCoordinate(buf, f)
+}
+
+// writeCoordinate writes a coordinate value, formatting it with the CoordinateFormatter if set.
+func (o *MarshalOptions) writeCoordinate(buf *bytes.Buffer, f float64) error {
+	if o.CoordinateFormatter == nil {
+		data, err := json.Marshal(f)
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+		return nil
+	}
+
+	s := o.CoordinateFormatter(f)
+	if !isJSONNumber(s) {
+		return ErrInvalidFormattedNumber
+	}
+
+	buf.WriteString(s)
+	return nil
+}
+
+// padAltitude appends the default altitude to a 2D position, or inserts it as both minimum and maximum
+// altitude of a 2D bounding box, right before the container is closed.
+func (o *MarshalOptions) padAltitude(buf *bytes.Buffer, frame *marshalFrame) error {
+	if o.DefaultAltitude == nil || frame == nil || frame.object || !frame.coordinates || frame.numbers != frame.count {
+		return nil
+	}
+
+	switch {
+	case frame.bbox && frame.count == bboxSize2D:
+		maxValues := slices.Clone(buf.Bytes()[frame.bboxSplit:])
+		buf.Truncate(frame.bboxSplit)
+		if err := o.writeCoordinate(buf, *o.DefaultAltitude); err != nil {
+			return err
+		}
+		buf.WriteByte(',')
+		buf.Write(maxValues)
+	case !frame.bbox && frame.count == coordsMinLen:
+	default:
+		return nil
+	}
+
+	buf.WriteByte(',')
+	return o.writeCoordinate(buf, *o.DefaultAltitude)
+}
+
+// endMarshalValue updates the innermost container after one of its values has been written.
+func endMarshalValue(stack []*marshalFrame) {
+	if len(stack) == 0 {
+		return
+	}
+
+	frame := stack[len(stack)-1]
+	frame.count++
+	frame.expectKey = frame.object
+}
+
+// isGeoJSONType reports whether s names a GeoJSON geometry, Feature, or FeatureCollection.
+func isGeoJSONType(s string) bool {
+	switch GeometryType(s) {
+	case TypePoint, TypeMultiPoint, TypeLineString, TypeMultiLineString, TypePolygon, TypeMultiPolygon, TypeGeometryCollection:
+		return true
+	}
+
+	return ObjectType(s) == TypeFeature || ObjectType(s) == TypeFeatureCollection
+}
+
+// writeJSONString writes a string using the same escaping as json.Marshal.
+func writeJSONString(buf *bytes.Buffer, s string) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	buf.Write(data)
+	return nil
+}
+
+// isJSONNumber reports whether s is a single JSON number.
+func isJSONNumber(s string) bool {
+	if s == "" || (s[0] != '-' && (s[0] < '0' || s[0] > '9')) {
+		return false
+	}
+
+	return json.Valid([]byte(s))
+}
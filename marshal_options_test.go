@@ -0,0 +1,249 @@
+package geojson
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixedPrecisionFormatter(t *testing.T) {
+	tests := []struct {
+		name     string
+		decimals int
+		value    float64
+		expected string
+	}{
+		{"pads with zeros", 5, 12.5, "12.50000"},
+		{"rounds", 2, -73.98567, "-73.99"},
+		{"no decimals", 0, 41.89, "42"},
+		{"negative decimals", -1, 41.89, "42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FixedPrecisionFormatter(tt.decimals)(tt.value))
+		})
+	}
+}
+
+func TestPrecisionFormatter(t *testing.T) {
+	tests := []struct {
+		name     string
+		decimals int
+		value    float64
+		expected string
+	}{
+		{"rounds", RecommendedPrecision, 12.49237461, "12.492375"},
+		{"no trailing zeros", RecommendedPrecision, 12.50000001, "12.5"},
+		{"integer value", RecommendedPrecision, 41.0000001, "41"},
+		{"negative zero", 2, -0.001, "0"},
+		{"no decimals", 0, 41.5, "42"},
+		{"large integer", 2, 1200, "1200"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, PrecisionFormatter(tt.decimals)(tt.value))
+		})
+	}
+}
+
+func TestSignificantDigitsFormatter(t *testing.T) {
+	tests := []struct {
+		name     string
+		digits   int
+		value    float64
+		expected string
+	}{
+		{"rounds integer part", 3, 12345.678, "12300"},
+		{"rounds decimals", 4, 12.34567, "12.35"},
+		{"small value without exponent", 2, 0.000012345, "0.000012"},
+		{"trailing zeros are dropped", 6, 12.5, "12.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, SignificantDigitsFormatter(tt.digits)(tt.value))
+		})
+	}
+}
+
+func TestMarshalOptions_Marshal(t *testing.T) {
+	point := MustPoint([]float64{12.4924, 41.8902})
+	point.SerializeBBox = true
+	altitude := 10.0
+
+	feature := NewFeatureBuilder().
+		SetGeometry(MustLineString(Vertices{{0.123456, 1.5}, {2, 3.75}})).
+		SetProperties(Properties{"coordinates": []float64{1.123456}, "bbox": 2.5, "<name>": "a&b"}).
+		SetID(*NewNumericID(7.5)).
+		Build()
+
+	tests := []struct {
+		name     string
+		options  MarshalOptions
+		value    interface{}
+		expected string
+	}{
+		{
+			name:     "default formatting",
+			options:  MarshalOptions{},
+			value:    point,
+			expected: `{"type":"Point","coordinates":[12.4924,41.8902],"bbox":[12.4924,41.8902,12.4924,41.8902]}`,
+		},
+		{
+			name:     "fixed precision point with bbox",
+			options:  MarshalOptions{CoordinateFormatter: FixedPrecisionFormatter(2)},
+			value:    point,
+			expected: `{"type":"Point","coordinates":[12.49,41.89],"bbox":[12.49,41.89,12.49,41.89]}`,
+		},
+		{
+			name:     "properties and id are untouched",
+			options:  MarshalOptions{CoordinateFormatter: FixedPrecisionFormatter(1)},
+			value:    &feature,
+			expected: `{"type":"Feature","geometry":{"type":"LineString","coordinates":[[0.1,1.5],[2.0,3.8]]},"properties":{"\u003cname\u003e":"a\u0026b","bbox":2.5,"coordinates":[1.123456]},"id":7.5}`,
+		},
+		{
+			name:    "precision through feature collection",
+			options: MarshalOptions{CoordinateFormatter: PrecisionFormatter(RecommendedPrecision)},
+			value: NewFeatureCollectionFromFeatures([]Feature{{
+				Geometry: NewGeometryCollectionFromSlice([]Geometry{MustPoint([]float64{12.4923746123, 41.8902, 7.0000001})}),
+			}}),
+			expected: `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[12.492375,41.8902,7]}]},"properties":null}]}`,
+		},
+		{
+			name:    "nested geometry collection",
+			options: MarshalOptions{CoordinateFormatter: SignificantDigitsFormatter(2)},
+			value: NewGeometryCollectionFromSlice([]Geometry{
+				MustPoint([]float64{1.234, 5.678}),
+				MustMultiLineString(Segments{{{0.111, 0.222}, {3.33, 4.44}}}),
+			}),
+			expected: `{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[1.2,5.7]},{"type":"MultiLineString","coordinates":[[[0.11,0.22],[3.3,4.4]]]}]}`,
+		},
+		{
+			name:    "foreign members are untouched",
+			options: MarshalOptions{CoordinateFormatter: FixedPrecisionFormatter(1), DefaultAltitude: &altitude},
+			value: &Feature{
+				Geometry: MustPoint([]float64{1.25, 2}),
+				ForeignMembers: map[string]json.RawMessage{
+					"extra": json.RawMessage(`{"bbox":[1.25,2,3,4],"coordinates":[1.25,2]}`),
+					"other": json.RawMessage(`{"type":"Point","coordinates":[1.25,2]}`),
+				},
+			},
+			expected: `{"type":"Feature","geometry":{"type":"Point","coordinates":[1.2,2.0,10.0]},"properties":null,` +
+				`"extra":{"bbox":[1.25,2,3,4],"coordinates":[1.25,2]},"other":{"type":"Point","coordinates":[1.25,2]}}`,
+		},
+		{
+			name:     "objects without a GeoJSON type are untouched",
+			options:  MarshalOptions{CoordinateFormatter: FixedPrecisionFormatter(1)},
+			value:    map[string]interface{}{"bbox": []float64{1.25, 2}, "type": "Point", "coordinates": []float64{1.25}},
+			expected: `{"bbox":[1.25,2],"coordinates":[1.25],"type":"Point"}`,
+		},
+		{
+			name:     "top-level number",
+			options:  MarshalOptions{CoordinateFormatter: FixedPrecisionFormatter(2), DefaultAltitude: &altitude},
+			value:    5.25,
+			expected: `5.25`,
+		},
+		{
+			name:     "top-level string",
+			options:  MarshalOptions{CoordinateFormatter: FixedPrecisionFormatter(2), DefaultAltitude: &altitude},
+			value:    "coordinates",
+			expected: `"coordinates"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.options.Marshal(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, string(data))
+			assert.True(t, json.Valid(data))
+		})
+	}
+}
+
+func TestMarshalOptions_Marshal_InvalidFormatter(t *testing.T) {
+	options := MarshalOptions{CoordinateFormatter: func(float64) string { return "NaN" }}
+
+	_, err := options.Marshal(MustPoint([]float64{1, 2}))
+	assert.ErrorIs(t, err, ErrInvalidFormattedNumber)
+}
+
+func TestMarshalOptions_Marshal_DefaultAltitude(t *testing.T) {
+	altitude := 100.5
+
+	line := MustLineString(Vertices{{0, 1}, {2, 3, 4}})
+	line.SerializeBBox = true
+
+	feature := NewFeatureBuilder().
+		SetGeometry(MustPoint([]float64{1, 2})).
+		SetProperties(Properties{"coordinates": []float64{1, 2}}).
+		Build()
+
+	tests := []struct {
+		name     string
+		options  MarshalOptions
+		value    interface{}
+		expected string
+	}{
+		{
+			name:     "2D point",
+			options:  MarshalOptions{DefaultAltitude: &altitude},
+			value:    MustPoint([]float64{12.4924, 41.8902}),
+			expected: `{"type":"Point","coordinates":[12.4924,41.8902,100.5]}`,
+		},
+		{
+			name:     "3D positions are untouched",
+			options:  MarshalOptions{DefaultAltitude: &altitude},
+			value:    line,
+			expected: `{"type":"LineString","coordinates":[[0,1,100.5],[2,3,4]],"bbox":[0,1,0,2,3,4]}`,
+		},
+		{
+			name:    "2D bbox",
+			options: MarshalOptions{DefaultAltitude: &altitude},
+			value: func() Geometry {
+				p := MustPolygon(LinearRings{*MustLinearRing(Vertices{{0, 0}, {1, 0}, {1, 1}, {0, 0}})})
+				p.SerializeBBox = true
+				return p
+			}(),
+			expected: `{"type":"Polygon","coordinates":[[[0,0,100.5],[1,0,100.5],[1,1,100.5],[0,0,100.5]]],"bbox":[0,0,100.5,1,1,100.5]}`,
+		},
+		{
+			name:     "properties are untouched",
+			options:  MarshalOptions{DefaultAltitude: &altitude},
+			value:    &feature,
+			expected: `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2,100.5]},"properties":{"coordinates":[1,2]}}`,
+		},
+		{
+			name:     "formatted altitude",
+			options:  MarshalOptions{DefaultAltitude: &altitude, CoordinateFormatter: FixedPrecisionFormatter(2)},
+			value:    NewMultiPointFromVertices(Vertices{{1, 2}}),
+			expected: `{"type":"MultiPoint","coordinates":[[1.00,2.00,100.50]]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.options.Marshal(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, string(data))
+			assert.True(t, json.Valid(data))
+		})
+	}
+}
+
+func TestMarshalOptions_Marshal_Indent(t *testing.T) {
+	point := MustPoint([]float64{1.23456789, 2})
+
+	options := MarshalOptions{CoordinateFormatter: PrecisionFormatter(2), Indent: "  "}
+	data, err := options.Marshal(point)
+	require.NoError(t, err)
+	assert.Equal(t, "{\n  \"type\": \"Point\",\n  \"coordinates\": [\n    1.23,\n    2\n  ]\n}", string(data))
+
+	data, err = MarshalIndent(point, "> ", "\t")
+	require.NoError(t, err)
+	assert.Equal(t, "{\n> \t\"type\": \"Point\",\n> \t\"coordinates\": [\n> \t\t1.23456789,\n> \t\t2\n> \t]\n> }", string(data))
+}
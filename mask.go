@@ -0,0 +1,173 @@
+package geojson
+
+import (
+	"math"
+	"slices"
+)
+
+const (
+	// maskTolerance is the distance, in degrees, within which a position is considered to lie
+	// on the boundary of a mask polygon. It absorbs the rounding of computed intersection points.
+	maskTolerance = 1e-9
+)
+
+// FractionInside returns the fraction of the length of the LineString that lies inside the mask polygon,
+// from 0 to 1. Parts running along the boundary of the mask count as inside, and holes of the mask count
+// as outside. Each edge is split where it crosses the boundary of the mask, treating longitude and latitude
+// as planar coordinates, and the resulting pieces are measured as great-circle lengths.
+// It returns 0 if the mask is nil or the LineString has zero length.
+func (l *LineString) FractionInside(mask *Polygon) float64 {
+	if mask == nil || len(mask.rings) == 0 {
+		return 0
+	}
+
+	maskEdges := ringsEdges(mask.rings)
+
+	var total, inside float64
+	for _, e := range verticesEdges(l.vertices) {
+		for _, piece := range splitEdge(e, maskEdges) {
+			length := piece.length()
+			total += length
+
+			if location, _ := locateInMask(mask.rings, maskEdges, piece.midpoint()); location != ringExterior {
+				inside += length
+			}
+		}
+	}
+
+	if total == 0 {
+		return 0
+	}
+
+	return math.Min(1, inside/total)
+}
+
+// FractionInside returns the fraction of the area of the Polygon that lies inside the mask polygon,
+// from 0 to 1, accounting for the holes of both. Like Area, the computation treats longitude and latitude
+// as planar coordinates. The area of the intersection is obtained by applying the shoelace formula to its
+// boundary, made of the parts of each polygon's boundary that lie inside the other one.
+// It returns 0 if the mask is nil or the Polygon has zero area.
+func (p *Polygon) FractionInside(mask *Polygon) float64 {
+	if mask == nil || len(mask.rings) == 0 {
+		return 0
+	}
+
+	area := polygonArea(p.rings)
+	if area == 0 {
+		return 0
+	}
+
+	edges, maskEdges := ringsEdges(p.rings), ringsEdges(mask.rings)
+
+	// Both polygons follow the right-hand rule, so their interiors lie to the left of their edges,
+	// and the boundary of the intersection is traversed counterclockwise around it.
+	var intersection float64
+	for _, e := range edges {
+		for _, piece := range splitEdge(e, maskEdges) {
+			// Boundary parts shared by both polygons are counted once, here, and only when both
+			// interiors lie on the same side of them.
+			location, nearest := locateInMask(mask.rings, maskEdges, piece.midpoint())
+			if location == ringInterior || (location == ringBoundary && piece.sameDirection(nearest)) {
+				intersection += piece.cross()
+			}
+		}
+	}
+	for _, e := range maskEdges {
+		for _, piece := range splitEdge(e, edges) {
+			if location, _ := locateInMask(p.rings, edges, piece.midpoint()); location == ringInterior {
+				intersection += piece.cross()
+			}
+		}
+	}
+
+	return math.Max(0, math.Min(1, intersection/2/area))
+}
+
+// midpoint returns the position halfway along the edge in planar coordinates.
+func (e edge) midpoint() Coordinates {
+	return interpolateLinear(e.start, e.end, 0.5)
+}
+
+// cross returns the contribution of the edge to the shoelace formula, twice the signed area
+// of the triangle formed by the origin and the edge.
+func (e edge) cross() float64 {
+	return e.start[idxCoordsLng]*e.end[idxCoordsLat] - e.end[idxCoordsLng]*e.start[idxCoordsLat]
+}
+
+// sameDirection reports whether the edge and the other edge point in the same planar direction.
+func (e edge) sameDirection(other edge) bool {
+	return (e.end[idxCoordsLng]-e.start[idxCoordsLng])*(other.end[idxCoordsLng]-other.start[idxCoordsLng])+
+		(e.end[idxCoordsLat]-e.start[idxCoordsLat])*(other.end[idxCoordsLat]-other.start[idxCoordsLat]) > 0
+}
+
+// splitEdge splits the edge at every position where it crosses or touches one of the cutters,
+// treating longitude and latitude as planar coordinates, and returns the pieces in order.
+func splitEdge(e edge, cutters []edge) []edge {
+	rx := e.end[idxCoordsLng] - e.start[idxCoordsLng]
+	ry := e.end[idxCoordsLat] - e.start[idxCoordsLat]
+
+	cuts := []float64{0, 1}
+	for _, c := range cutters {
+		sx := c.end[idxCoordsLng] - c.start[idxCoordsLng]
+		sy := c.end[idxCoordsLat] - c.start[idxCoordsLat]
+		wx := c.start[idxCoordsLng] - e.start[idxCoordsLng]
+		wy := c.start[idxCoordsLat] - e.start[idxCoordsLat]
+
+		denominator := rx*sy - ry*sx
+		if denominator == 0 {
+			// Collinear cutters split the edge where their endpoints fall within it.
+			if wx*ry-wy*rx == 0 {
+				for _, t := range []float64{planarProjection(c.start, e.start, e.end), planarProjection(c.end, e.start, e.end)} {
+					if t > 0 && t < 1 {
+						cuts = append(cuts, t)
+					}
+				}
+			}
+			continue
+		}
+
+		t := (wx*sy - wy*sx) / denominator
+		u := (wx*ry - wy*rx) / denominator
+		if t > 0 && t < 1 && u >= 0 && u <= 1 {
+			cuts = append(cuts, t)
+		}
+	}
+
+	slices.Sort(cuts)
+	cuts = slices.Compact(cuts)
+
+	pieces := make([]edge, 0, len(cuts)-1)
+	start := e.start
+	for i := 1; i < len(cuts); i++ {
+		end := e.end
+		if i < len(cuts)-1 {
+			end = interpolateLinear(e.start, e.end, cuts[i])
+		}
+		pieces = append(pieces, edge{start: start, end: end})
+		start = end
+	}
+
+	return pieces
+}
+
+// locateInMask reports whether the position lies in the interior, on the boundary, or in the exterior
+// of the polygon defined by the rings, whose edges are given. Positions within maskTolerance of an edge
+// lie on the boundary, in which case the closest edge is also returned.
+func locateInMask(rings LinearRings, edges []edge, c Coordinates) (int, edge) {
+	var nearest edge
+	distance := math.Inf(1)
+	for _, e := range edges {
+		if d := planarDistanceToSegment(c, e.start, e.end); d < distance {
+			nearest, distance = e, d
+		}
+	}
+
+	switch {
+	case distance <= maskTolerance:
+		return ringBoundary, nearest
+	case ringsContain(rings, c):
+		return ringInterior, edge{}
+	default:
+		return ringExterior, edge{}
+	}
+}
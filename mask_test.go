@@ -0,0 +1,69 @@
+package geojson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rectangle returns a Polygon covering the given extent, with optional rectangular holes.
+func rectangle(minLng, minLat, maxLng, maxLat float64, holes ...LinearRing) *Polygon {
+	rings := LinearRings{{{minLng, minLat}, {maxLng, minLat}, {maxLng, maxLat}, {minLng, maxLat}, {minLng, minLat}}}
+	return MustPolygon(append(rings, holes...))
+}
+
+func TestLineString_FractionInside(t *testing.T) {
+	mask := rectangle(1, -1, 3, 1)
+	ring := rectangle(0, -2, 4, 2, LinearRing{{1, -1}, {3, -1}, {3, 1}, {1, 1}, {1, -1}})
+
+	tests := []struct {
+		name     string
+		line     *LineString
+		mask     *Polygon
+		expected float64
+	}{
+		{"crossing", MustLineString(Vertices{{0, 0}, {4, 0}}), mask, 0.5},
+		{"inside", MustLineString(Vertices{{1.5, 0}, {2.5, 0.5}}), mask, 1},
+		{"outside", MustLineString(Vertices{{5, 0}, {6, 0}}), mask, 0},
+		{"along the boundary", MustLineString(Vertices{{1, -1}, {3, -1}}), mask, 1},
+		{"entering and leaving twice", MustLineString(Vertices{{0, 0}, {2, 0}, {2, 3}}), mask, 2.0 / 5},
+		{"through a hole", MustLineString(Vertices{{0, 0}, {4, 0}}), ring, 0.5},
+		{"nil mask", MustLineString(Vertices{{0, 0}, {4, 0}}), nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, tt.line.FractionInside(tt.mask), 1e-3)
+		})
+	}
+}
+
+func TestPolygon_FractionInside(t *testing.T) {
+	// An L-shaped, non-convex mask covering the bottom and left of the square [0, 4].
+	lShape := MustPolygon(LinearRings{{{0, 0}, {4, 0}, {4, 1}, {1, 1}, {1, 4}, {0, 4}, {0, 0}}})
+
+	tests := []struct {
+		name     string
+		polygon  *Polygon
+		mask     *Polygon
+		expected float64
+	}{
+		{"half overlap", rectangle(0, 0, 2, 2), rectangle(1, 0, 3, 2), 0.5},
+		{"identical", rectangle(0, 0, 2, 2), rectangle(0, 0, 2, 2), 1},
+		{"adjacent", rectangle(0, 0, 2, 2), rectangle(2, 0, 4, 2), 0},
+		{"disjoint", rectangle(0, 0, 1, 1), rectangle(5, 5, 6, 6), 0},
+		{"polygon inside mask", rectangle(1, 1, 2, 2), rectangle(0, 0, 4, 4), 1},
+		{"mask inside polygon", rectangle(0, 0, 4, 4), rectangle(1, 1, 2, 2), 1.0 / 16},
+		{"mask with hole", rectangle(0, 0, 4, 4), rectangle(0, 0, 4, 4, LinearRing{{1, 1}, {3, 1}, {3, 3}, {1, 3}, {1, 1}}), 0.75},
+		{"mask within hole", rectangle(0, 0, 4, 4, LinearRing{{1, 1}, {3, 1}, {3, 3}, {1, 3}, {1, 1}}), rectangle(1, 1, 3, 3), 0},
+		{"non-convex mask", rectangle(0, 0, 4, 4), lShape, 7.0 / 16},
+		{"crossing a non-convex mask", rectangle(0.5, 0.5, 2.5, 2.5), lShape, 1.75 / 4},
+		{"nil mask", rectangle(0, 0, 1, 1), nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, tt.polygon.FractionInside(tt.mask), 1e-9)
+		})
+	}
+}
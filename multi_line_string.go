@@ -3,6 +3,7 @@ package geojson
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 var (
@@ -13,7 +14,9 @@ var (
 // MultiLineString represents a GeoJSON MultiLineString geometry.
 type MultiLineString struct {
 	segments      Segments // Segments that define the MultiLineString.
+	declaredBBox           // Bounding box declared in the decoded GeoJSON, if any.
 	SerializeBBox bool     // Indicates whether the bounding box should be included during JSON serialization.
+	PreserveBBox  bool     // Indicates whether the declared bounding box should be included when SerializeBBox is false.
 }
 
 // BoundingBox calculates and returns the bounding box of the MultiLineString.
@@ -35,13 +38,72 @@ func (m *MultiLineString) Type() GeometryType {
 	return TypeMultiLineString
 }
 
+// AsGeometryObject wraps the MultiLineString in a GeometryObject.
+func (m *MultiLineString) AsGeometryObject() GeometryObject {
+	return FromGeometry(m)
+}
+
 // Segments returns the collection of segments that define the MultiLineString.
 func (m *MultiLineString) Segments() Segments {
 	return m.segments
 }
 
+// Length returns the total length of the MultiLineString in meters, summing the lengths of its segments
+// as computed by LineString.Length. The gaps between segments are not included.
+func (m *MultiLineString) Length() float64 {
+	length := 0.0
+	for _, s := range m.segments {
+		length += verticesLength(s)
+	}
+	return length
+}
+
+// Reverse reverses the order of the segments of the MultiLineString and the order of the vertices
+// within each segment, in place, so that the whole geometry is traversed in the opposite direction.
+func (m *MultiLineString) Reverse() {
+	for _, s := range m.segments {
+		slices.Reverse(s)
+	}
+	slices.Reverse(m.segments)
+}
+
+// ToLineString converts a MultiLineString holding exactly one segment into a LineString with a copy
+// of its vertices. It returns ErrNotSingleElement otherwise.
+func (m *MultiLineString) ToLineString() (*LineString, error) {
+	if len(m.segments) != 1 {
+		return nil, ErrNotSingleElement
+	}
+
+	return &LineString{vertices: cloneVertices(m.segments[0])}, nil
+}
+
+// Len returns the number of line strings, or segments, of the MultiLineString.
+func (m *MultiLineString) Len() int {
+	return len(m.segments)
+}
+
+// LineStringAt returns a LineString with a copy of the vertices of the segment at index i, which can be
+// modified without affecting the MultiLineString. It returns ErrGeometryIndex if i is out of range.
+func (m *MultiLineString) LineStringAt(i int) (*LineString, error) {
+	if i < 0 || i >= len(m.segments) {
+		return nil, fmt.Errorf("%w: %d", ErrGeometryIndex, i)
+	}
+
+	return &LineString{vertices: cloneVertices(m.segments[i])}, nil
+}
+
+// EstimatedJSONSize returns the approximate size in bytes of the GeoJSON representation of the MultiLineString.
+func (m *MultiLineString) EstimatedJSONSize() int {
+	coordinatesSize := jsonNullSize
+	if m.segments != nil {
+		coordinatesSize = estimateSegmentsSize(m.segments)
+	}
+
+	return estimateGeometrySize(m.Type(), coordinatesSize, m.serializedBBox(m, m.SerializeBBox, m.PreserveBBox))
+}
+
 // buildCoordinates processes raw GeoJSON coordinates and constructs the segments of the MultiLineString.
-func (m *MultiLineString) buildCoordinates(v interface{}) error {
+func (m *MultiLineString) buildCoordinates(v interface{}, opts decodeOptions) error {
 	rawSlice, ok := v.([]interface{})
 	if !ok {
 		return ErrInvalidCoordinates
@@ -54,7 +116,7 @@ func (m *MultiLineString) buildCoordinates(v interface{}) error {
 	segments := make(Segments, len(rawSlice))
 	for i, s := range rawSlice {
 		l := LineString{}
-		if err := l.buildCoordinates(s); err != nil {
+		if err := l.buildCoordinates(s, opts); err != nil {
 			return err
 		}
 		segments[i] = l.vertices
@@ -71,29 +133,14 @@ func (m *MultiLineString) MarshalJSON() ([]byte, error) {
 		Coordinates: m.segments,
 	}
 
-	if m.SerializeBBox {
-		out.BBox = m.BoundingBox()
-	}
+	out.BBox = m.serializedBBox(m, m.SerializeBBox, m.PreserveBBox)
 
 	return json.Marshal(&out)
 }
 
 // UnmarshalJSON parses a GeoJSON representation into a MultiLineString.
 func (m *MultiLineString) UnmarshalJSON(data []byte) error {
-	gw := &GeometryObject{}
-
-	if err := gw.UnmarshalJSON(data); err != nil {
-		return fmt.Errorf("failed to unmarshal %s: %w", m.Type(), err)
-	}
-
-	g, ok := gw.geometry.(*MultiLineString)
-	if !ok {
-		return ErrInvalidTypeField
-	}
-
-	m.segments = g.segments
-
-	return nil
+	return decodeGeometryInto(m, data, decodeOptions{})
 }
 
 // NewMultiLineString creates a new MultiLineString with the provided segments.
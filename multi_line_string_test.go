@@ -1,6 +1,7 @@
 package geojson
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -131,7 +132,7 @@ func TestMultiLineString_buildCoordinates(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			m := &MultiLineString{}
-			err := m.buildCoordinates(tc.input)
+			err := m.buildCoordinates(tc.input, decodeOptions{})
 			require.ErrorIs(t, err, tc.expectErr)
 		})
 	}
@@ -308,3 +309,50 @@ func TestMultiLineString_Segments(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiLineString_Reverse(t *testing.T) {
+	m := MustMultiLineString(Segments{{{0, 0}, {1, 1}}, {{2, 2}, {3, 3}, {4, 4}}})
+	m.Reverse()
+	assert.Equal(t, Segments{{{4, 4}, {3, 3}, {2, 2}}, {{1, 1}, {0, 0}}}, m.Segments())
+
+	data, err := m.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"MultiLineString","coordinates":[[[4,4],[3,3],[2,2]],[[1,1],[0,0]]]}`, string(data))
+}
+
+func TestMultiLineString_ToLineString(t *testing.T) {
+	l, err := MustMultiLineString(Segments{{{0, 0}, {1, 1}}}).ToLineString()
+	require.NoError(t, err)
+	assert.Equal(t, Vertices{{0, 0}, {1, 1}}, l.Vertices())
+
+	l, err = MustMultiLineString(Segments{{{0, 0}, {1, 1}}, {{2, 2}, {3, 3}}}).ToLineString()
+	assert.ErrorIs(t, err, ErrNotSingleElement)
+	assert.Nil(t, l)
+}
+
+func TestMultiLineString_LineStringAt(t *testing.T) {
+	m := MustMultiLineString(Segments{{{0, 0}, {1, 1}}, {{2, 2}, {3, 3}, {4, 4}}})
+	assert.Equal(t, 2, m.Len())
+	assert.Zero(t, (&MultiLineString{}).Len())
+
+	l, err := m.LineStringAt(1)
+	require.NoError(t, err)
+	assert.Equal(t, Vertices{{2, 2}, {3, 3}, {4, 4}}, l.Vertices())
+
+	l.Vertices()[0][0] = 9
+	assert.Equal(t, Coordinates{2, 2}, m.Segments()[1][0], "the line string should not alias the segment")
+
+	for _, i := range []int{-1, 2} {
+		l, err = m.LineStringAt(i)
+		assert.ErrorIs(t, err, ErrGeometryIndex)
+		assert.Nil(t, l)
+	}
+}
+
+func TestMultiLineString_Length(t *testing.T) {
+	degree := EarthMeanRadius * math.Pi / 180
+
+	m := MustMultiLineString(Segments{{{0, 0}, {1, 0}}, {{10, 0}, {10, 2}}})
+	assert.InDelta(t, 3*degree, m.Length(), 1e-6)
+	assert.Zero(t, (&MultiLineString{}).Length())
+}
@@ -2,13 +2,16 @@ package geojson
 
 import (
 	"encoding/json"
-	"fmt"
+	"math"
+	"slices"
 )
 
 // MultiPoint represents a GeoJSON MultiPoint geometry.
 type MultiPoint struct {
 	vertices      Vertices // The vertices of the MultiPoint geometry.
+	declaredBBox           // Bounding box declared in the decoded GeoJSON, if any.
 	SerializeBBox bool     // Indicates whether to serialize the bounding box.
+	PreserveBBox  bool     // Indicates whether to serialize the declared bounding box when SerializeBBox is false.
 }
 
 // BoundingBox calculates and returns the bounding box of the MultiPoint geometry.
@@ -26,9 +29,77 @@ func (m *MultiPoint) Type() GeometryType {
 	return TypeMultiPoint
 }
 
+// AsGeometryObject wraps the MultiPoint in a GeometryObject.
+func (m *MultiPoint) AsGeometryObject() GeometryObject {
+	return FromGeometry(m)
+}
+
+// EstimatedJSONSize returns the approximate size in bytes of the GeoJSON representation of the MultiPoint.
+func (m *MultiPoint) EstimatedJSONSize() int {
+	return estimateGeometrySize(m.Type(), estimateVerticesSize(m.vertices), m.serializedBBox(m, m.SerializeBBox, m.PreserveBBox))
+}
+
+// Add appends a copy of the coordinates to the positions of the MultiPoint.
+// It returns an error if the coordinates have an invalid size or an out-of-range longitude or latitude,
+// leaving the MultiPoint unchanged.
+func (m *MultiPoint) Add(c Coordinates) error {
+	coords, err := NewCoordinates(c)
+	if err != nil {
+		return err
+	}
+
+	m.vertices = append(m.vertices, *coords)
+	return nil
+}
+
+// AngularSpread returns how fully the points of the MultiPoint surround the given center, in degrees.
+// It computes the initial great-circle bearing from the center to every point and returns
+// 360 minus the largest angular gap between consecutive bearings. Points equal to the center
+// are ignored. A result of 0 means there are no points around the center, or all of them lie on
+// the same bearing; values close to 360 mean the points surround the center evenly.
+func (m *MultiPoint) AngularSpread(center Coordinates) float64 {
+	bearings := make([]float64, 0, len(m.vertices))
+	for _, v := range m.vertices {
+		if v.Longitude() == center.Longitude() && v.Latitude() == center.Latitude() {
+			continue
+		}
+		bearings = append(bearings, center.BearingTo(v))
+	}
+
+	if len(bearings) == 0 {
+		return 0
+	}
+
+	slices.Sort(bearings)
+
+	// The gap that wraps around north, between the last and the first bearing.
+	largestGap := 360 - bearings[len(bearings)-1] + bearings[0]
+	for i := 1; i < len(bearings); i++ {
+		largestGap = math.Max(largestGap, bearings[i]-bearings[i-1])
+	}
+
+	return 360 - largestGap
+}
+
+// ToPoint converts a MultiPoint holding exactly one position into a Point with a copy of that position.
+// It returns ErrNotSingleElement otherwise.
+func (m *MultiPoint) ToPoint() (*Point, error) {
+	if len(m.vertices) != 1 {
+		return nil, ErrNotSingleElement
+	}
+
+	return &Point{coords: slices.Clone(m.vertices[0])}, nil
+}
+
+// ConvexHull returns the convex hull of the positions of the MultiPoint as ConvexHullPolygon does.
+// Returns ErrInsufficientPoints if there are fewer than 3 distinct, non-collinear points.
+func (m *MultiPoint) ConvexHull() (*Polygon, error) {
+	return ConvexHullPolygon(m.vertices)
+}
+
 // buildCoordinates populates the MultiPoint with vertices from the provided raw data.
 // It returns an error if the input is invalid.
-func (m *MultiPoint) buildCoordinates(v interface{}) error {
+func (m *MultiPoint) buildCoordinates(v interface{}, opts decodeOptions) error {
 	rawSlice, ok := v.([]interface{})
 	if !ok {
 		return ErrInvalidCoordinates
@@ -37,7 +108,7 @@ func (m *MultiPoint) buildCoordinates(v interface{}) error {
 	vertices := make(Vertices, len(rawSlice))
 	for i, s := range rawSlice {
 		p := Point{}
-		if err := p.buildCoordinates(s); err != nil {
+		if err := p.buildCoordinates(s, opts); err != nil {
 			return err
 		}
 
@@ -61,9 +132,7 @@ func (m *MultiPoint) MarshalJSON() ([]byte, error) {
 		Coordinates: vertices,
 	}
 
-	if m.SerializeBBox {
-		out.BBox = m.BoundingBox()
-	}
+	out.BBox = m.serializedBBox(m, m.SerializeBBox, m.PreserveBBox)
 
 	return json.Marshal(&out)
 }
@@ -71,19 +140,7 @@ func (m *MultiPoint) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON deserializes the GeoJSON representation of a MultiPoint.
 // It returns an error if the input data is not valid or doesn't match a MultiPoint.
 func (m *MultiPoint) UnmarshalJSON(data []byte) error {
-	gw := &GeometryObject{}
-
-	if err := gw.UnmarshalJSON(data); err != nil {
-		return fmt.Errorf("failed to unmarshal %s: %w", m.Type(), err)
-	}
-
-	g, ok := gw.geometry.(*MultiPoint)
-	if !ok {
-		return ErrInvalidTypeField
-	}
-
-	m.vertices = g.vertices
-	return nil
+	return decodeGeometryInto(m, data, decodeOptions{})
 }
 
 // NewMultiPointFromVertices creates and returns a new MultiPoint from the given vertices.
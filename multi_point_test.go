@@ -71,7 +71,7 @@ func TestMultiPoint_buildCoordinates(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			m := &MultiPoint{}
-			err := m.buildCoordinates(tt.input)
+			err := m.buildCoordinates(tt.input, decodeOptions{})
 			if tt.wantErr != nil {
 				require.Error(t, err)
 				assert.ErrorIs(t, err, tt.wantErr)
@@ -153,3 +153,108 @@ func TestNewMultiPointFromVertices(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiPoint_AngularSpread(t *testing.T) {
+	center := Coordinates{0, 0}
+
+	tests := []struct {
+		name     string
+		vertices Vertices
+		expected float64
+	}{
+		{"no points", Vertices{}, 0},
+		{"only the center", Vertices{{0, 0}}, 0},
+		{"single point", Vertices{{0, 1}}, 0},
+		{"north and east", Vertices{{0, 1}, {1, 0}}, 90},
+		{"north and south", Vertices{{0, 1}, {0, -1}}, 180},
+		{"all four directions", Vertices{{0, 1}, {1, 0}, {0, -1}, {-1, 0}}, 270},
+		{"gap across north", Vertices{{-1, 0}, {1, 0}, {0, -1}}, 180},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMultiPointFromVertices(tt.vertices)
+			assert.InDelta(t, tt.expected, m.AngularSpread(center), 1e-9)
+		})
+	}
+}
+
+func TestMultiPoint_ToPoint(t *testing.T) {
+	m := NewMultiPointFromVertices(Vertices{{1, 2, 3}})
+	p, err := m.ToPoint()
+	require.NoError(t, err)
+	assert.Equal(t, Coordinates{1, 2, 3}, p.Coordinates())
+
+	p.coords[idxCoordsLng] = 5
+	assert.Equal(t, Vertices{{1, 2, 3}}, m.Vertices())
+
+	for _, v := range []Vertices{nil, {{1, 2}, {3, 4}}} {
+		p, err = NewMultiPointFromVertices(v).ToPoint()
+		assert.ErrorIs(t, err, ErrNotSingleElement)
+		assert.Nil(t, p)
+	}
+}
+
+func TestMultiPoint_ConvexHull(t *testing.T) {
+	tests := []struct {
+		name        string
+		vertices    Vertices
+		expected    *Polygon
+		expectedErr error
+	}{
+		{
+			name:     "square with interior and duplicated points",
+			vertices: Vertices{{1, 1}, {0, 0}, {2, 0}, {2, 2}, {0, 2}, {1, 1}, {0, 0}},
+			expected: MustPolygon(LinearRings{*MustLinearRing(Vertices{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}})}),
+		},
+		{
+			name:        "two distinct points",
+			vertices:    Vertices{{0, 0}, {1, 1}, {0, 0}},
+			expectedErr: ErrInsufficientPoints,
+		},
+		{
+			name:        "collinear points",
+			vertices:    Vertices{{0, 0}, {1, 1}, {2, 2}},
+			expectedErr: ErrInsufficientPoints,
+		},
+		{
+			name:        "empty",
+			expectedErr: ErrInsufficientPoints,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hull, err := NewMultiPointFromVertices(tt.vertices).ConvexHull()
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, hull)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, hull)
+			ring := hull.OuterRing()
+			assert.True(t, ring.IsCounterClockwise())
+		})
+	}
+
+	m := NewMultiPointFromVertices(Vertices{{0, 0}, {2, 0}, {1, 2}})
+	hull, err := m.ConvexHull()
+	require.NoError(t, err)
+	hull.rings[0][0][0] = 9
+	assert.Equal(t, Vertices{{0, 0}, {2, 0}, {1, 2}}, m.Vertices())
+}
+
+func TestMultiPoint_Add(t *testing.T) {
+	m := NewMultiPointFromVertices(Vertices{{0, 0}})
+	require.NoError(t, m.Add(Coordinates{1, 2}))
+
+	c := Coordinates{3, 4, 5}
+	require.NoError(t, m.Add(c))
+	c[0] = 99
+
+	assert.ErrorIs(t, m.Add(Coordinates{181, 0}), ErrLongitudeRange)
+	assert.ErrorIs(t, m.Add(Coordinates{1, 2, 3, 4}), ErrCoordinatesSize)
+	assert.Equal(t, Vertices{{0, 0}, {1, 2}, {3, 4, 5}}, m.Vertices())
+}
@@ -3,12 +3,18 @@ package geojson
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 // MultiPolygon represents a GeoJSON MultiPolygon geometry.
+// When SerializeBBox is true the computed bounding box is serialized; otherwise, when PreserveBBox is true,
+// the bounding box declared in the decoded GeoJSON, if any, is serialized unchanged.
 type MultiPolygon struct {
-	rings         []LinearRings
+	rings []LinearRings
+	declaredBBox
 	SerializeBBox bool
+	PreserveBBox  bool
+	cachedBBox    BoundingBox // Bounding box memoized by CachedBoundingBox, if any.
 }
 
 // Type returns the geometry type for MultiPolygon.
@@ -16,6 +22,26 @@ func (m *MultiPolygon) Type() GeometryType {
 	return TypeMultiPolygon
 }
 
+// AsGeometryObject wraps the MultiPolygon in a GeometryObject.
+func (m *MultiPolygon) AsGeometryObject() GeometryObject {
+	return FromGeometry(m)
+}
+
+// CachedBoundingBox returns the bounding box of the MultiPolygon like BoundingBox, but computes it only
+// on the first call and returns a copy of the memoized value afterwards. The cache is cleared by the methods
+// of the MultiPolygon that change its polygons; call InvalidateBBox after modifying the rings by other means.
+func (m *MultiPolygon) CachedBoundingBox() BoundingBox {
+	if m.cachedBBox == nil {
+		m.cachedBBox = m.BoundingBox()
+	}
+	return slices.Clone(m.cachedBBox)
+}
+
+// InvalidateBBox clears the bounding box memoized by CachedBoundingBox, so the next call computes it again.
+func (m *MultiPolygon) InvalidateBBox() {
+	m.cachedBBox = nil
+}
+
 // Vertices collects and returns all vertices contained in the MultiPolygon.
 func (m *MultiPolygon) Vertices() Vertices {
 	var v Vertices
@@ -37,6 +63,92 @@ func (m *MultiPolygon) LinearRingsSlice() []LinearRings {
 	return m.rings
 }
 
+// ToPolygon converts a MultiPolygon holding exactly one polygon into a Polygon with a copy of its rings.
+// It returns ErrNotSingleElement otherwise.
+func (m *MultiPolygon) ToPolygon() (*Polygon, error) {
+	if len(m.rings) != 1 {
+		return nil, ErrNotSingleElement
+	}
+
+	return &Polygon{rings: mapLinearRings(m.rings[0], cloneVertices)}, nil
+}
+
+// Len returns the number of polygons of the MultiPolygon.
+func (m *MultiPolygon) Len() int {
+	return len(m.rings)
+}
+
+// PolygonAt returns a Polygon with a copy of the rings of the polygon at index i, which can be modified
+// without affecting the MultiPolygon. It returns ErrGeometryIndex if i is out of range.
+func (m *MultiPolygon) PolygonAt(i int) (*Polygon, error) {
+	if i < 0 || i >= len(m.rings) {
+		return nil, fmt.Errorf("%w: %d", ErrGeometryIndex, i)
+	}
+
+	return &Polygon{rings: mapLinearRings(m.rings[i], cloneVertices)}, nil
+}
+
+// AddPolygon appends a copy of the rings of the polygon to the MultiPolygon as a new polygon,
+// oriented following the right-hand rule. Nil polygons and polygons without rings are ignored.
+func (m *MultiPolygon) AddPolygon(p *Polygon) {
+	if p == nil || len(p.rings) == 0 {
+		return
+	}
+
+	rings := mapLinearRings(p.rings, cloneVertices)
+	ensureOrientation(rings)
+
+	m.rings = append(m.rings, rings)
+	m.InvalidateBBox()
+}
+
+// Simplify returns a new MultiPolygon whose rings are each simplified like those of Polygon.Simplify, using
+// a tolerance expressed in degrees of longitude and latitude. Rings that collapse to a segment, since all their
+// positions lie within the tolerance of it, are dropped instead of being kept with a minimum of positions, and
+// a polygon is dropped with its holes when its outer ring collapses. Polygons are simplified independently,
+// so boundaries shared between them are not dissolved and may no longer match after simplification.
+func (m *MultiPolygon) Simplify(tolerance float64) *MultiPolygon {
+	var slice []LinearRings
+	for _, rings := range m.rings {
+		if len(rings) == 0 || ringCollapses(rings[0], tolerance) {
+			continue
+		}
+
+		simplified := LinearRings{simplifyRing(rings[0], tolerance)}
+		for _, ring := range rings[1:] {
+			if !ringCollapses(ring, tolerance) {
+				simplified = append(simplified, simplifyRing(ring, tolerance))
+			}
+		}
+		ensureOrientation(simplified)
+
+		slice = append(slice, simplified)
+	}
+
+	return &MultiPolygon{rings: slice}
+}
+
+// EstimatedJSONSize returns the approximate size in bytes of the GeoJSON representation of the MultiPolygon.
+func (m *MultiPolygon) EstimatedJSONSize() int {
+	size := 0
+	for _, rings := range m.rings {
+		size += estimateLinearRingsSize(rings)
+	}
+
+	return estimateGeometrySize(m.Type(), estimateArraySize(size, len(m.rings)), m.serializedBBox(m, m.SerializeBBox, m.PreserveBBox))
+}
+
+// Area computes the planar area of the MultiPolygon as the sum of the areas of its polygons.
+// Like Polygon.Area, it uses the shoelace formula on planar coordinates and is not a geodesic area.
+func (m *MultiPolygon) Area() float64 {
+	area := 0.0
+	for _, rings := range m.rings {
+		area += polygonArea(rings)
+	}
+
+	return area
+}
+
 // MarshalJSON serializes the MultiPolygon to its GeoJSON representation.
 func (m *MultiPolygon) MarshalJSON() ([]byte, error) {
 	rings := m.rings
@@ -49,34 +161,26 @@ func (m *MultiPolygon) MarshalJSON() ([]byte, error) {
 		Coordinates: rings,
 	}
 
-	if m.SerializeBBox {
-		out.BBox = m.BoundingBox()
-	}
+	out.BBox = m.serializedBBox(m, m.SerializeBBox, m.PreserveBBox)
 
 	return json.Marshal(&out)
 }
 
 // UnmarshalJSON deserializes the GeoJSON representation into a MultiPolygon.
 func (m *MultiPolygon) UnmarshalJSON(data []byte) error {
-	gw := &GeometryObject{}
-
-	if err := gw.UnmarshalJSON(data); err != nil {
-		return fmt.Errorf("failed to unmarshal %s: %w", m.Type(), err)
-	}
+	return decodeGeometryInto(m, data, decodeOptions{})
+}
 
-	g, ok := gw.geometry.(*MultiPolygon)
-	if !ok {
-		return ErrInvalidTypeField
+// NewMultiPolygon creates a new MultiPolygon holding the given polygons, added in order as AddPolygon does:
+// their rings are copied and oriented following the right-hand rule, and nil polygons and polygons without
+// rings are ignored. Without arguments it returns an empty MultiPolygon.
+func NewMultiPolygon(polygons ...*Polygon) *MultiPolygon {
+	m := &MultiPolygon{}
+	for _, p := range polygons {
+		m.AddPolygon(p)
 	}
 
-	m.rings = g.rings
-
-	return nil
-}
-
-// NewMultiPolygon creates and returns a new empty MultiPolygon instance.
-func NewMultiPolygon() *MultiPolygon {
-	return &MultiPolygon{}
+	return m
 }
 
 // NewMultiPolygonFromRingSlice validates the provided slice of LinearRings and creates
@@ -113,7 +217,7 @@ func MustMultiPolygonFromRingSlice(slice []LinearRings) *MultiPolygon {
 }
 
 // buildCoordinates initializes the MultiPolygon rings based on the provided raw coordinate data.
-func (m *MultiPolygon) buildCoordinates(v interface{}) error {
+func (m *MultiPolygon) buildCoordinates(v interface{}, opts decodeOptions) error {
 	rawSlice, ok := v.([]interface{})
 	if !ok {
 		return ErrInvalidCoordinates
@@ -123,7 +227,7 @@ func (m *MultiPolygon) buildCoordinates(v interface{}) error {
 	for i, s := range rawSlice {
 		p := Polygon{}
 
-		if err := p.buildCoordinates(s); err != nil {
+		if err := p.buildCoordinates(s, opts); err != nil {
 			return err
 		}
 
@@ -131,6 +235,7 @@ func (m *MultiPolygon) buildCoordinates(v interface{}) error {
 	}
 
 	m.rings = lrSlice
+	m.InvalidateBBox()
 
 	return nil
 }
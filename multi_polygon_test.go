@@ -313,7 +313,7 @@ func TestMultiPolygon_buildCoordinates(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			m := &MultiPolygon{}
-			err := m.buildCoordinates(tt.input)
+			err := m.buildCoordinates(tt.input, decodeOptions{})
 			if tt.wantErr {
 				require.Error(t, err, "Expected an error for input: %v", tt.input)
 			} else {
@@ -373,3 +373,162 @@ func TestMultiPolygon_LinearRingsSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiPolygon_Area(t *testing.T) {
+	tests := []struct {
+		name         string
+		multiPolygon *MultiPolygon
+		expected     float64
+	}{
+		{
+			name: "sum of polygons",
+			multiPolygon: MustMultiPolygonFromRingSlice([]LinearRings{
+				{
+					*MustLinearRing(Vertices{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}),
+					*MustLinearRing(Vertices{{1, 1}, {2, 1}, {2, 2}, {1, 2}, {1, 1}}),
+				},
+				{
+					*MustLinearRing(Vertices{{10, 10}, {12, 10}, {12, 12}, {10, 10}}),
+				},
+			}),
+			expected: 17,
+		},
+		{
+			name:         "empty multi polygon",
+			multiPolygon: NewMultiPolygon(),
+			expected:     0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, tt.multiPolygon.Area(), 1e-9)
+		})
+	}
+}
+
+func TestNewMultiPolygon(t *testing.T) {
+	assert.Equal(t, &MultiPolygon{}, NewMultiPolygon())
+
+	square := LinearRing{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}
+	first := MustPolygon(LinearRings{square})
+	second := &Polygon{rings: LinearRings{{{5, 5}, {5, 9}, {9, 9}, {9, 5}, {5, 5}}}}
+
+	m := NewMultiPolygon(first, nil, second)
+	assert.Equal(t, []LinearRings{
+		{square},
+		{{{5, 5}, {9, 5}, {9, 9}, {5, 9}, {5, 5}}},
+	}, m.LinearRingsSlice())
+
+	first.rings[0][0][0] = 0.5
+	assert.Equal(t, 0.0, m.LinearRingsSlice()[0][0][0][0], "rings are copied")
+}
+
+func TestMultiPolygon_AddPolygon(t *testing.T) {
+	m := NewMultiPolygon()
+	m.AddPolygon(nil)
+	m.AddPolygon(&Polygon{})
+	assert.Empty(t, m.LinearRingsSlice())
+
+	first := MustPolygon(LinearRings{*MustLinearRing(Vertices{{0, 0}, {1, 0}, {1, 1}, {0, 0}})})
+	m.AddPolygon(first)
+
+	// Rings built without NewPolygon are oriented when added.
+	second := &Polygon{rings: LinearRings{
+		{{5, 5}, {5, 9}, {9, 9}, {9, 5}, {5, 5}},
+		{{6, 6}, {7, 6}, {7, 7}, {6, 6}},
+	}}
+	m.AddPolygon(second)
+
+	assert.Equal(t, []LinearRings{
+		{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+		{{{5, 5}, {9, 5}, {9, 9}, {5, 9}, {5, 5}}, {{6, 6}, {7, 7}, {7, 6}, {6, 6}}},
+	}, m.LinearRingsSlice())
+
+	// The MultiPolygon does not share positions with the added polygon.
+	first.rings[0][0][idxCoordsLng] = 0.5
+	assert.Equal(t, Coordinates{0, 0}, m.LinearRingsSlice()[0][0][0])
+	assert.Equal(t, LinearRing{{5, 5}, {5, 9}, {9, 9}, {9, 5}, {5, 5}}, second.rings[0])
+}
+
+func TestMultiPolygon_ToPolygon(t *testing.T) {
+	rings := LinearRings{
+		{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+		{{1, 1}, {2, 2}, {2, 1}, {1, 1}},
+	}
+
+	p, err := MustMultiPolygonFromRingSlice([]LinearRings{rings}).ToPolygon()
+	require.NoError(t, err)
+	assert.Equal(t, rings, p.LinearRings())
+
+	p, err = NewMultiPolygon().ToPolygon()
+	assert.ErrorIs(t, err, ErrNotSingleElement)
+	assert.Nil(t, p)
+}
+
+func TestMultiPolygon_PolygonAt(t *testing.T) {
+	first := LinearRings{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}}
+	second := LinearRings{
+		{{5, 5}, {9, 5}, {9, 9}, {5, 9}, {5, 5}},
+		{{6, 6}, {7, 7}, {7, 6}, {6, 6}},
+	}
+	m := MustMultiPolygonFromRingSlice([]LinearRings{first, second})
+	assert.Equal(t, 2, m.Len())
+	assert.Zero(t, NewMultiPolygon().Len())
+
+	p, err := m.PolygonAt(1)
+	require.NoError(t, err)
+	assert.Equal(t, second, p.LinearRings())
+
+	p.LinearRings()[0][0][0] = 0
+	assert.Equal(t, Coordinates{5, 5}, m.LinearRingsSlice()[1][0][0], "the polygon should not alias the rings")
+
+	for _, i := range []int{-1, 2} {
+		p, err = m.PolygonAt(i)
+		assert.ErrorIs(t, err, ErrGeometryIndex)
+		assert.Nil(t, p)
+	}
+}
+
+func TestMultiPolygon_CachedBoundingBox(t *testing.T) {
+	m := MustMultiPolygonFromRingSlice([]LinearRings{
+		{*MustLinearRing(Vertices{{0, 0}, {1, 0}, {1, 1}, {0, 0}})},
+	})
+	assert.Equal(t, BoundingBox{0, 0, 1, 1}, m.CachedBoundingBox())
+
+	m.AddPolygon(MustPolygon(LinearRings{*MustLinearRing(Vertices{{5, 5}, {6, 5}, {6, 6}, {5, 5}})}))
+	assert.Equal(t, BoundingBox{0, 0, 6, 6}, m.CachedBoundingBox())
+
+	m.rings[0][0][0][0] = -3
+	assert.Equal(t, BoundingBox{0, 0, 6, 6}, m.CachedBoundingBox())
+	m.InvalidateBBox()
+	assert.Equal(t, BoundingBox{-3, 0, 6, 6}, m.CachedBoundingBox())
+}
+
+func TestMultiPolygon_Simplify(t *testing.T) {
+	m := MustMultiPolygonFromRingSlice([]LinearRings{
+		{
+			*MustLinearRing(Vertices{{0, 0}, {5, 0.01}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}),
+			*MustLinearRing(Vertices{{4, 4}, {4, 6}, {6, 6}, {6, 4}, {4, 4}}),
+			*MustLinearRing(Vertices{{2, 2}, {2, 2.05}, {2.05, 2.05}, {2.05, 2}, {2, 2}}),
+		},
+		{
+			*MustLinearRing(Vertices{{20, 20}, {20.05, 20}, {20.05, 20.05}, {20, 20}}),
+		},
+	})
+
+	simplified := m.Simplify(0.1)
+	assert.Equal(t, []LinearRings{
+		{
+			{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+			{{4, 4}, {4, 6}, {6, 6}, {6, 4}, {4, 4}},
+		},
+	}, simplified.LinearRingsSlice())
+
+	// The original MultiPolygon is not modified.
+	assert.Len(t, m.LinearRingsSlice(), 2)
+	assert.Len(t, m.LinearRingsSlice()[0][0], 6)
+
+	assert.Empty(t, m.Simplify(100).LinearRingsSlice())
+	assert.Equal(t, m.LinearRingsSlice(), m.Simplify(0).LinearRingsSlice())
+}
@@ -17,6 +17,50 @@ type Object struct {
 	features    *FeatureCollection // The FeatureCollection represented by the object, if applicable.
 }
 
+// Unmarshal decodes any GeoJSON object, choosing how from its type member. It returns a *Feature for
+// a Feature, a *FeatureCollection for a FeatureCollection, and the concrete Geometry, such as *Point or
+// *GeometryCollection, for a geometry, as UnmarshalGeometry does. It returns ErrInvalidTypeField for missing
+// or unknown types, and the decoding error of the object otherwise.
+func Unmarshal(data []byte) (interface{}, error) {
+	return UnmarshalWithOptions(data)
+}
+
+// UnmarshalWithOptions decodes any GeoJSON object like Unmarshal, applying the UnmarshalOptions configured
+// by the DecodeOptions, in order, as UnmarshalOptions.Unmarshal does. Unlike UnmarshalOptions.Unmarshal,
+// it returns no value along with an error.
+func UnmarshalWithOptions(data []byte, opts ...DecodeOption) (interface{}, error) {
+	var options UnmarshalOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	var input typeJSONInput
+	if err := json.Unmarshal(data, &input); err != nil {
+		return nil, err
+	}
+
+	switch input.Type {
+	case string(TypeFeature):
+		f := &Feature{}
+		if err := options.Unmarshal(data, f); err != nil {
+			return nil, err
+		}
+		return f, nil
+	case string(TypeFeatureCollection):
+		fc := &FeatureCollection{}
+		if err := options.Unmarshal(data, fc); err != nil {
+			return nil, err
+		}
+		return fc, nil
+	default:
+		g := &GeometryObject{}
+		if err := options.Unmarshal(data, g); err != nil {
+			return nil, err
+		}
+		return g.geometry, nil
+	}
+}
+
 // Type returns the type of the GeoJSON object.
 func (o *Object) Type() ObjectType {
 	if o.featureType == "" {
@@ -72,24 +116,48 @@ func (o *Object) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON decodes JSON data into the Object.
 // Identifies if the Object is a single Feature or a FeatureCollection, and unmarshals accordingly.
 func (o *Object) UnmarshalJSON(bytes []byte) error {
+	return o.decode(bytes, decodeOptions{})
+}
+
+// decode decodes JSON data into the Object applying the decoding options to the geometries it holds.
+func (o *Object) decode(bytes []byte, opts decodeOptions) error {
 	var feature featuresJSONInput
 	if err := json.Unmarshal(bytes, &feature); err != nil {
 		return fmt.Errorf("failed to unmarshal features: %w", err)
 	}
 
-	if feature.Geometry == nil {
-		feature.Geometry = &GeometryObject{}
-	}
-
 	switch feature.Type {
 	case TypeFeature:
+		var geometry Geometry
+		if feature.Geometry != nil && string(feature.Geometry) != "null" {
+			v, err := decodeGeometry(feature.Geometry, opts)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal features: %w", err)
+			}
+			geometry = v
+		}
+
+		foreign, err := decodeForeignMembers(bytes, featureMembers)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal foreign members: %w", err)
+		}
+
 		o.feature = &Feature{
-			Geometry:   feature.Geometry.geometry,
-			Properties: feature.Properties,
-			ID:         feature.ID,
+			Geometry:       geometry,
+			Properties:     feature.Properties,
+			ID:             feature.ID,
+			ForeignMembers: foreign,
+			geometryAbsent: feature.Geometry == nil,
 		}
 	case TypeFeatureCollection:
-		v := NewFeatureCollectionFromFeatures(feature.Features)
+		v, err := buildFeatureCollection(feature.Features, feature.BBox, opts)
+		if err != nil {
+			return err
+		}
+
+		if v.ForeignMembers, err = decodeForeignMembers(bytes, featureCollectionMembers); err != nil {
+			return fmt.Errorf("failed to unmarshal foreign members: %w", err)
+		}
 		o.features = v
 	default:
 		return ErrInvalidFeature
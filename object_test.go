@@ -115,7 +115,7 @@ func TestObject_MarshalJSON(t *testing.T) {
 		expected string
 		wantErr  error
 	}{
-		{"marshalFeature", Object{featureType: TypeFeature, feature: &Feature{}}, `{"type":"Feature","geometry":null}`, nil},
+		{"marshalFeature", Object{featureType: TypeFeature, feature: &Feature{}}, `{"type":"Feature","geometry":null,"properties":null}`, nil},
 		{"marshalCollection", Object{featureType: TypeFeatureCollection, features: &FeatureCollection{}}, `{"type":"FeatureCollection","features":[]}`, nil},
 		{"marshalInvalid", Object{}, "", ErrInvalidFeature},
 	}
@@ -190,3 +190,104 @@ func TestObject_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshal(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    interface{}
+		expectedErr error
+	}{
+		{
+			name:     "feature",
+			input:    `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,1]},"properties":{"a":1}}`,
+			expected: &Feature{Geometry: MustPoint([]float64{1, 1}), Properties: Properties{"a": 1.0}},
+		},
+		{
+			name:     "feature collection",
+			input:    `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":null,"properties":null}]}`,
+			expected: &FeatureCollection{Features: []Feature{{}}},
+		},
+		{
+			name:     "geometry",
+			input:    `{"type":"MultiPoint","coordinates":[[1,2],[3,4]]}`,
+			expected: NewMultiPointFromVertices(Vertices{{1, 2}, {3, 4}}),
+		},
+		{
+			name:        "unknown type",
+			input:       `{"type":"Topology"}`,
+			expectedErr: ErrInvalidTypeField,
+		},
+		{
+			name:        "invalid feature",
+			input:       `{"type":"Feature","geometry":{"type":"Point","coordinates":[1]}}`,
+			expectedErr: ErrCoordinatesSize,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := Unmarshal([]byte(tt.input))
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, v)
+				return
+			}
+
+			require.NoError(t, err)
+			switch expected := tt.expected.(type) {
+			case *Feature:
+				require.IsType(t, expected, v)
+				assert.True(t, expected.Equal(v.(*Feature)))
+			case *FeatureCollection:
+				require.IsType(t, expected, v)
+				assert.Len(t, v.(*FeatureCollection).Features, len(expected.Features))
+			case Geometry:
+				require.IsType(t, expected, v)
+				assert.True(t, GeometriesEqual(expected, v.(Geometry)))
+			}
+		})
+	}
+
+	_, err := Unmarshal([]byte(`invalid`))
+	assert.Error(t, err)
+}
+
+func TestUnmarshalWithOptions(t *testing.T) {
+	t.Run("longitude normalization", func(t *testing.T) {
+		data := []byte(`{"type":"Feature","geometry":{"type":"LineString","coordinates":[[350,1],[180,2],[10,3]]},"properties":null}`)
+
+		v, err := UnmarshalWithOptions(data, WithLongitudeNormalization())
+		require.NoError(t, err)
+		require.IsType(t, &Feature{}, v)
+		assert.Equal(t, Vertices{{-10, 1}, {180, 2}, {10, 3}}, v.(*Feature).Geometry.Vertices())
+
+		_, err = UnmarshalWithOptions(data)
+		assert.ErrorIs(t, err, ErrLongitudeRange)
+	})
+
+	t.Run("longitude beyond 360", func(t *testing.T) {
+		_, err := UnmarshalWithOptions([]byte(`{"type":"Point","coordinates":[361,0]}`), WithLongitudeNormalization())
+		assert.ErrorIs(t, err, ErrLongitudeRange)
+	})
+
+	t.Run("custom option", func(t *testing.T) {
+		v, err := UnmarshalWithOptions([]byte(`{"type":"Point","coordinates":[190,0,0,5]}`),
+			WithLongitudeNormalization(),
+			func(o *UnmarshalOptions) { o.AllowMeasure = true },
+		)
+		require.NoError(t, err)
+		require.IsType(t, &Point{}, v)
+		assert.Equal(t, Coordinates{-170, 0, 0, 5}, v.(*Point).Coordinates())
+	})
+
+	t.Run("required region", func(t *testing.T) {
+		region := BoundingBox{-20, -20, 20, 20}
+		v, err := UnmarshalWithOptions(
+			[]byte(`{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[30,0]},"properties":null}]}`),
+			func(o *UnmarshalOptions) { o.RequireWithinBBox = &region },
+		)
+		assert.ErrorIs(t, err, ErrCoordinateOutsideRegion)
+		assert.Nil(t, v)
+	})
+}
@@ -2,13 +2,17 @@ package geojson
 
 import (
 	"encoding/json"
-	"fmt"
+	"slices"
 )
 
 // Point represents a GeoJSON Point object with coordinates and optional serialization for a bounding box.
+// When SerializeBBox is true the computed bounding box is serialized; otherwise, when PreserveBBox is true,
+// the bounding box declared in the decoded GeoJSON, if any, is serialized unchanged.
 type Point struct {
-	coords        Coordinates
+	coords Coordinates
+	declaredBBox
 	SerializeBBox bool
+	PreserveBBox  bool
 }
 
 // BoundingBox computes the bounding box of the Point.
@@ -55,14 +59,47 @@ func (p *Point) Type() GeometryType {
 	return TypePoint
 }
 
+// AsGeometryObject wraps the Point in a GeometryObject.
+func (p *Point) AsGeometryObject() GeometryObject {
+	return FromGeometry(p)
+}
+
+// EstimatedJSONSize returns the approximate size in bytes of the GeoJSON representation of the Point.
+func (p *Point) EstimatedJSONSize() int {
+	return estimateGeometrySize(p.Type(), estimateCoordinatesSize(p.coords), p.serializedBBox(p, p.SerializeBBox, p.PreserveBBox))
+}
+
+// Buffer returns a Polygon approximating the geodesic circle of radius radiusMeters around the Point, as a
+// regular polygon with the given number of vertices. The vertices are placed at evenly spaced bearings from
+// the Point, starting north, on a sphere of radius EarthMeanRadius, and altitude is ignored. A segments value
+// lower than 3 is clamped to 3. The ring is closed and counterclockwise, following the right-hand rule.
+//
+// Circles crossing the antimeridian or enclosing a pole are not split, so they produce a ring spanning the
+// whole longitude range that is not meant to be rendered as is.
+func (p *Point) Buffer(radiusMeters float64, segments int) *Polygon {
+	segments = max(segments, 3)
+
+	ring := make(LinearRing, 0, segments+1)
+	for i := 0; i < segments; i++ {
+		bearing := 360 * float64(i) / float64(segments)
+		ring = append(ring, destination(p.coords, bearing, radiusMeters))
+	}
+	ring = append(ring, slices.Clone(ring[0]))
+
+	rings := LinearRings{ring}
+	ensureOrientation(rings)
+
+	return &Polygon{rings: rings}
+}
+
 // buildCoordinates creates the coordinates for the Point from a raw slice of interface{}.
-func (p *Point) buildCoordinates(v interface{}) error {
+func (p *Point) buildCoordinates(v interface{}, opts decodeOptions) error {
 	rawSlice, ok := v.([]interface{})
 	if !ok {
 		return ErrInvalidCoordinates
 	}
 
-	coords, err := buildCoordinates(rawSlice)
+	coords, err := buildCoordinates(rawSlice, opts)
 	if err != nil {
 		return err
 	}
@@ -79,29 +116,14 @@ func (p *Point) MarshalJSON() ([]byte, error) {
 		Coordinates: p.coords,
 	}
 
-	if p.SerializeBBox {
-		out.BBox = p.BoundingBox()
-	}
+	out.BBox = p.serializedBBox(p, p.SerializeBBox, p.PreserveBBox)
 
 	return json.Marshal(&out)
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface to parse GeoJSON data into a Point.
 func (p *Point) UnmarshalJSON(data []byte) error {
-	gw := &GeometryObject{}
-
-	if err := gw.UnmarshalJSON(data); err != nil {
-		return fmt.Errorf("failed to unmarshal %s: %w", p.Type(), err)
-	}
-
-	g, ok := gw.geometry.(*Point)
-	if !ok {
-		return ErrInvalidTypeField
-	}
-
-	p.coords = g.coords
-
-	return nil
+	return decodeGeometryInto(p, data, decodeOptions{})
 }
 
 // NewPoint creates a new Point from a slice of float64 coordinates.
@@ -1,6 +1,7 @@
 package geojson
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -197,7 +198,7 @@ func TestPoint_BuildCoordinates(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			point := &Point{}
-			err := point.buildCoordinates(tt.input)
+			err := point.buildCoordinates(tt.input, decodeOptions{})
 			if tt.hasError {
 				assert.Error(t, err)
 			} else {
@@ -380,3 +381,61 @@ func TestPoint_Coordinates(t *testing.T) {
 		})
 	}
 }
+
+func TestPoint_PreserveBBox(t *testing.T) {
+	input := `{"type":"Point","coordinates":[1,2],"bbox":[0,0,5,5]}`
+
+	var p Point
+	require.NoError(t, json.Unmarshal([]byte(input), &p))
+
+	bbox, ok := p.ParsedBBox()
+	assert.True(t, ok)
+	assert.Equal(t, BoundingBox{0, 0, 5, 5}, bbox)
+
+	data, err := json.Marshal(&p)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"Point","coordinates":[1,2]}`, string(data), "declared bbox should be dropped by default")
+
+	p.PreserveBBox = true
+	data, err = json.Marshal(&p)
+	require.NoError(t, err)
+	assert.JSONEq(t, input, string(data), "declared bbox should be preserved")
+
+	p.SerializeBBox = true
+	data, err = json.Marshal(&p)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"Point","coordinates":[1,2],"bbox":[1,2,1,2]}`, string(data), "computed bbox should take precedence")
+}
+
+func TestPoint_Buffer(t *testing.T) {
+	tests := []struct {
+		name         string
+		point        *Point
+		radius       float64
+		segments     int
+		wantVertices int
+	}{
+		{"32 segments", MustPoint([]float64{12.4924, 41.8902}), 1000, 32, 32},
+		{"altitude is ignored", MustPoint([]float64{-73.9857, 40.7484, 381}), 250, 8, 8},
+		{"fewer than 3 segments are clamped", MustPoint([]float64{0, 0}), 5000, 1, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			polygon := tt.point.Buffer(tt.radius, tt.segments)
+
+			rings := polygon.LinearRings()
+			require.Len(t, rings, 1)
+			ring := rings[0]
+			assert.Len(t, ring, tt.wantVertices+1)
+			assert.True(t, ring.IsClosed())
+			assert.True(t, ring.HasValidSize())
+			assert.True(t, ring.IsCounterClockwise())
+
+			for _, c := range ring {
+				assert.False(t, c.HasAltitude())
+				assert.InDelta(t, tt.radius, tt.point.coords.Distance(c), tt.radius*1e-9)
+			}
+		})
+	}
+}
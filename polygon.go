@@ -3,6 +3,8 @@ package geojson
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"slices"
 )
 
 var (
@@ -13,7 +15,10 @@ var (
 // Polygon represents a geometric rings defined by a series of rings.
 type Polygon struct {
 	rings         LinearRings // The rings that comprise the polygon.
+	declaredBBox              // Bounding box declared in the decoded GeoJSON, if any.
 	SerializeBBox bool        // Flag to indicate if the bounding box should be serialized.
+	PreserveBBox  bool        // Flag to indicate if the declared bounding box should be serialized when SerializeBBox is false.
+	cachedBBox    BoundingBox // Bounding box memoized by CachedBoundingBox, if any.
 }
 
 // BoundingBox calculates and returns the minimum bounding box for the polygon.
@@ -21,6 +26,22 @@ func (p *Polygon) BoundingBox() BoundingBox {
 	return bbox(p.Vertices())
 }
 
+// CachedBoundingBox returns the minimum bounding box for the polygon like BoundingBox, but computes it only
+// on the first call and returns a copy of the memoized value afterwards. The cache is cleared by the methods
+// of the Polygon that change its rings; call InvalidateBBox after modifying the rings by other means,
+// such as through the slices returned by LinearRings.
+func (p *Polygon) CachedBoundingBox() BoundingBox {
+	if p.cachedBBox == nil {
+		p.cachedBBox = p.BoundingBox()
+	}
+	return slices.Clone(p.cachedBBox)
+}
+
+// InvalidateBBox clears the bounding box memoized by CachedBoundingBox, so the next call computes it again.
+func (p *Polygon) InvalidateBBox() {
+	p.cachedBBox = nil
+}
+
 // Vertices retrieves all the vertices that make up the polygon, combining all the vertices from its rings.
 func (p *Polygon) Vertices() Vertices {
 	var v Vertices
@@ -35,6 +56,11 @@ func (p *Polygon) Type() GeometryType {
 	return TypePolygon
 }
 
+// AsGeometryObject wraps the Polygon in a GeometryObject.
+func (p *Polygon) AsGeometryObject() GeometryObject {
+	return FromGeometry(p)
+}
+
 // LinearRings returns the collection of linear rings that make up the polygon.
 // The first ring represents the outer boundary, and subsequent rings represent holes.
 func (p *Polygon) LinearRings() LinearRings {
@@ -61,6 +87,255 @@ func (p *Polygon) InnerRings() LinearRings {
 	return p.rings[1:]
 }
 
+// IsRightHandRule reports whether the rings of the Polygon follow the right-hand rule of RFC 7946,
+// with the outer ring counterclockwise and the inner rings clockwise. The Polygon is not modified.
+func (p *Polygon) IsRightHandRule() bool {
+	for i := range p.rings {
+		if p.rings[i].IsCounterClockwise() != (i == 0) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SetOuterRing replaces the outer ring of the Polygon, or sets it if the Polygon has no rings.
+// The ring is copied and oriented counterclockwise, following the right-hand rule.
+// It returns ErrLinearRingSize or ErrLinearRingClosed if the ring is invalid, leaving the Polygon unchanged.
+func (p *Polygon) SetOuterRing(lr LinearRing) error {
+	ring, err := NewLinearRing(cloneVertices(Vertices(lr)))
+	if err != nil {
+		return err
+	}
+
+	if len(p.rings) == 0 {
+		p.rings = LinearRings{*ring}
+	} else {
+		p.rings[0] = *ring
+	}
+	ensureOrientation(p.rings)
+	p.InvalidateBBox()
+
+	return nil
+}
+
+// AddInnerRing adds a hole to the Polygon. The ring is copied and oriented clockwise, following
+// the right-hand rule; whether it lies within the outer ring is not checked. It returns ErrLinearRingSize
+// or ErrLinearRingClosed if the ring is invalid, or ErrPolygonLinearRingCount if the Polygon has no outer ring.
+func (p *Polygon) AddInnerRing(lr LinearRing) error {
+	if len(p.rings) == 0 {
+		return ErrPolygonLinearRingCount
+	}
+
+	ring, err := NewLinearRing(cloneVertices(Vertices(lr)))
+	if err != nil {
+		return err
+	}
+
+	p.rings = append(p.rings, *ring)
+	ensureOrientation(p.rings)
+	p.InvalidateBBox()
+
+	return nil
+}
+
+// EstimatedJSONSize returns the approximate size in bytes of the GeoJSON representation of the Polygon.
+func (p *Polygon) EstimatedJSONSize() int {
+	coordinatesSize := jsonNullSize
+	if p.rings != nil {
+		coordinatesSize = estimateLinearRingsSize(p.rings)
+	}
+
+	return estimateGeometrySize(p.Type(), coordinatesSize, p.serializedBBox(p, p.SerializeBBox, p.PreserveBBox))
+}
+
+// Area computes the planar area of the Polygon using the shoelace formula: the area of the outer ring
+// minus the areas of the inner rings, clamped to zero. Coordinates are treated as planar values,
+// so the result is in square degrees and is not a geodesic area.
+func (p *Polygon) Area() float64 {
+	return polygonArea(p.rings)
+}
+
+// GeodesicArea computes the area of the Polygon on a spherical model of the Earth, in square meters:
+// the geodesic area of the outer ring minus those of the inner rings, clamped to zero.
+// See LinearRing.GeodesicArea for details.
+func (p *Polygon) GeodesicArea() float64 {
+	if len(p.rings) == 0 {
+		return 0
+	}
+
+	area := p.rings[0].GeodesicArea()
+	for _, ring := range p.rings[1:] {
+		area -= ring.GeodesicArea()
+	}
+
+	return math.Max(0, area)
+}
+
+// Perimeter returns the length of the boundary of the Polygon in meters: the sum of the lengths of the outer
+// ring and of all inner rings, computed with the haversine formula. Rings are closed, so only consecutive
+// positions are summed. Altitude is ignored.
+func (p *Polygon) Perimeter() float64 {
+	perimeter := 0.0
+	for _, ring := range p.rings {
+		perimeter += verticesLength(Vertices(ring))
+	}
+	return perimeter
+}
+
+// Contains reports whether the point lies inside the Polygon, using the ray-casting algorithm
+// on longitude and latitude. Points on the boundary, including the boundary of a hole, are
+// considered contained, while points strictly inside a hole are not. Ring orientation is irrelevant.
+func (p *Polygon) Contains(pt Point) bool {
+	if len(p.rings) == 0 || len(pt.coords) < coordsMinLen {
+		return false
+	}
+
+	return ringsContain(p.rings, pt.coords)
+}
+
+// ContainsLineString checks if the LineString lies entirely inside the Polygon, boundary included, as Contains
+// does for points, treating longitude and latitude as planar coordinates. Checking the vertices alone is not
+// enough, since an edge between two inside vertices can cross a concave notch of the outer ring or a hole,
+// so every edge is also required not to cross the rings, and the parts of an edge between the positions where
+// it touches them, such as vertices of a notch, are required to lie inside. A LineString running along the
+// boundary is contained. It returns false for a Polygon without rings or a LineString without vertices.
+func (p *Polygon) ContainsLineString(l *LineString) bool {
+	if len(p.rings) == 0 || len(l.vertices) == 0 {
+		return false
+	}
+
+	for _, c := range l.vertices {
+		if len(c) < coordsMinLen || !ringsContain(p.rings, c) {
+			return false
+		}
+	}
+
+	ringEdges := ringsEdges(p.rings)
+	for _, e := range verticesEdges(l.vertices) {
+		// Fractions along the edge of the positions where it touches the rings.
+		touches := []float64{0, 1}
+		for _, r := range ringEdges {
+			if e.crosses(r) {
+				return false
+			}
+			for _, c := range []Coordinates{r.start, r.end} {
+				if cross(e.start, e.end, c) == 0 && e.spans(c) {
+					touches = append(touches, planarProjection(c, e.start, e.end))
+				}
+			}
+		}
+
+		slices.Sort(touches)
+		for i := 1; i < len(touches); i++ {
+			if touches[i] == touches[i-1] {
+				continue
+			}
+			middle := interpolateLinear(e.start, e.end, (touches[i-1]+touches[i])/2)
+			if !ringsContain(p.rings, middle) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// ringsContain reports whether the position lies inside the polygon defined by the rings or on its boundary.
+// The first ring is the exterior one and the others are holes.
+func ringsContain(rings LinearRings, c Coordinates) bool {
+	if len(rings) == 0 || locatePoint(rings[0], c) == ringExterior {
+		return false
+	}
+
+	for _, ring := range rings[1:] {
+		if locatePoint(ring, c) == ringInterior {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SharedBoundaryLength returns the total great-circle length in meters of the edges that the Polygon
+// shares with the other polygon, considering the edges of all rings. Edges are shared when they join
+// equal positions, in either direction, so adjacent polygons must use the same vertices along their
+// common boundary. It returns 0 when the polygons share no edges.
+func (p *Polygon) SharedBoundaryLength(other *Polygon) float64 {
+	if other == nil {
+		return 0
+	}
+
+	otherEdges := ringsEdges(other.rings)
+
+	length := 0.0
+	for _, e := range ringsEdges(p.rings) {
+		for _, o := range otherEdges {
+			if e.isEqual(o) {
+				length += e.length()
+				break
+			}
+		}
+	}
+
+	return length
+}
+
+// Simplify returns a new Polygon whose rings are each simplified with the Ramer-Douglas-Peucker algorithm,
+// using a tolerance expressed in degrees of longitude and latitude. Every ring stays closed and keeps at least
+// LinearRingMinimumSize positions, and the rings are oriented following the right-hand rule.
+// Simplification may make rings touch or cross each other, and the result is not checked for that.
+func (p *Polygon) Simplify(tolerance float64) *Polygon {
+	rings := make(LinearRings, len(p.rings))
+	for i, ring := range p.rings {
+		rings[i] = simplifyRing(ring, tolerance)
+	}
+	ensureOrientation(rings)
+
+	return &Polygon{rings: rings}
+}
+
+// Subsample returns a new Polygon whose rings each keep every nth position, starting from the first, and are
+// closed again, following the right-hand rule. Like LineString.Subsample, the number of positions kept depends
+// only on n; for rings too short to keep 3 distinct positions with a step of n, the step is reduced. A value
+// of n lower than 2 keeps every position.
+func (p *Polygon) Subsample(n int) *Polygon {
+	rings := make(LinearRings, len(p.rings))
+	for i, ring := range p.rings {
+		rings[i] = subsampleRing(ring, n)
+	}
+	ensureOrientation(rings)
+
+	return &Polygon{rings: rings}
+}
+
+// Densify returns a new Polygon whose rings are each densified like LineString.Densify, so that no edge is
+// longer than maxSegmentLength, expressed in degrees of longitude and latitude. Rings stay closed and keep
+// their orientation, since the new positions lie on the existing edges.
+func (p *Polygon) Densify(maxSegmentLength float64) *Polygon {
+	return &Polygon{rings: mapLinearRings(p.rings, func(v Vertices) Vertices {
+		return densifyVertices(v, maxSegmentLength)
+	})}
+}
+
+// Concavity measures how far the Polygon departs from its convex hull, computed as
+// 1 - area / hull area, where the hull is built from the outer ring and the area accounts for holes.
+// The result is clamped to [0, 1]: a convex polygon without holes returns 0.
+// Degenerate polygons, whose hull cannot be computed, return 0.
+func (p *Polygon) Concavity() float64 {
+	hull, err := ConvexHull(Vertices(p.OuterRing()), true)
+	if err != nil {
+		return 0
+	}
+
+	hullArea := hull.Area()
+	if hullArea == 0 {
+		return 0
+	}
+
+	return math.Max(0, math.Min(1, 1-p.Area()/hullArea))
+}
+
 // MarshalJSON converts the polygon into its JSON representation as per the GeoJSON specification.
 // If SerializeBBox is enabled, the bounding box will also be included in the output.
 func (p *Polygon) MarshalJSON() ([]byte, error) {
@@ -70,10 +345,8 @@ func (p *Polygon) MarshalJSON() ([]byte, error) {
 		Coordinates: p.rings,
 	}
 
-	// Include the bounding box if SerializeBBox is enabled.
-	if p.SerializeBBox {
-		out.BBox = p.BoundingBox()
-	}
+	// Include the computed or declared bounding box, depending on SerializeBBox and PreserveBBox.
+	out.BBox = p.serializedBBox(p, p.SerializeBBox, p.PreserveBBox)
 
 	// Convert the structure to JSON bytes.
 	return json.Marshal(&out)
@@ -82,27 +355,26 @@ func (p *Polygon) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON parses the polygon data from its JSON representation.
 // It ensures the parsed data matches the structure of a valid polygon.
 func (p *Polygon) UnmarshalJSON(data []byte) error {
-	gw := &GeometryObject{}
-
-	// Parse the JSON data into a temporary GeometryObject structure.
-	if err := gw.UnmarshalJSON(data); err != nil {
-		return fmt.Errorf("failed to unmarshal %s: %w", p.Type(), err)
-	}
+	return decodeGeometryInto(p, data, decodeOptions{})
+}
 
-	// Check if the geometry type is a Polygon.
-	g, ok := gw.geometry.(*Polygon)
-	if !ok {
-		return ErrInvalidTypeField
+// NewPolygon creates a new Polygon instance initialized with the provided linear rings.
+// The rings are oriented following the right-hand rule, reversing them in place if needed.
+// Returns an error if the number of rings is zero.
+func NewPolygon(rings LinearRings) (*Polygon, error) {
+	p, err := NewPolygonPreservingOrientation(rings)
+	if err != nil {
+		return nil, err
 	}
 
-	p.rings = g.rings
+	ensureOrientation(p.rings)
 
-	return nil
+	return p, nil
 }
 
-// NewPolygon creates a new Polygon instance initialized with the provided linear rings.
-// Returns an error if the number of rings is zero.
-func NewPolygon(rings LinearRings) (*Polygon, error) {
+// NewPolygonPreservingOrientation creates a new Polygon like NewPolygon, but keeps the winding order of the
+// rings as provided, for data that must retain it. Use IsRightHandRule to check the orientation.
+func NewPolygonPreservingOrientation(rings LinearRings) (*Polygon, error) {
 	// Validate the input to ensure at least one ring is provided.
 	if len(rings) == 0 {
 		return nil, ErrPolygonLinearRingCount
@@ -117,8 +389,6 @@ func NewPolygon(rings LinearRings) (*Polygon, error) {
 		}
 	}
 
-	ensureOrientation(rings)
-
 	return &Polygon{rings: rings}, nil
 }
 
@@ -135,7 +405,7 @@ func MustPolygon(rings LinearRings) *Polygon {
 
 // buildCoordinates populates the polygon's rings from the provided raw coordinate data.
 // It validates and converts the raw data into a series of segments representing the rings of the polygon.
-func (p *Polygon) buildCoordinates(v interface{}) error {
+func (p *Polygon) buildCoordinates(v interface{}, opts decodeOptions) error {
 	rawSlice, ok := v.([]interface{})
 	if !ok {
 		return ErrInvalidCoordinates
@@ -151,7 +421,7 @@ func (p *Polygon) buildCoordinates(v interface{}) error {
 
 		ring := make(Vertices, len(rawRing))
 		for j, rv := range rawRing {
-			coords, err := buildCoordinates(rv)
+			coords, err := buildCoordinates(rv, opts)
 			if err != nil {
 				return err
 			}
@@ -172,13 +442,31 @@ func (p *Polygon) buildCoordinates(v interface{}) error {
 		return ErrPolygonLinearRingCount
 	}
 
-	ensureOrientation(rings)
+	if !opts.preserveOrientation {
+		ensureOrientation(rings)
+	}
 
 	p.rings = rings
+	p.InvalidateBBox()
 
 	return nil
 }
 
+// polygonArea computes the planar area of a polygon given its rings,
+// subtracting the area of the inner rings from the outer ring and clamping the result to zero.
+func polygonArea(rings LinearRings) float64 {
+	if len(rings) == 0 {
+		return 0
+	}
+
+	area := rings[0].Area()
+	for _, ring := range rings[1:] {
+		area -= ring.Area()
+	}
+
+	return math.Max(0, area)
+}
+
 // ensureOrientation ensures the rings in a LinearRings collection
 // are properly oriented according to their roles in a polygon.
 // The first ring (outer ring) is oriented in a counterclockwise direction,
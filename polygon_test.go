@@ -2,9 +2,11 @@ package geojson
 
 import (
 	"encoding/json"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPolygon_Vertices(t *testing.T) {
@@ -68,7 +70,7 @@ func TestPolygon_buildCoordinates(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := &Polygon{}
-			err := p.buildCoordinates(tt.input)
+			err := p.buildCoordinates(tt.input, decodeOptions{})
 			assert.ErrorIs(t, err, tt.wantErr, "buildCoordinates() mismatch")
 		})
 	}
@@ -156,6 +158,47 @@ func TestNewPolygon(t *testing.T) {
 	}
 }
 
+func TestNewPolygonPreservingOrientation(t *testing.T) {
+	clockwise := LinearRing{{0, 0}, {0, 10}, {10, 10}, {10, 0}, {0, 0}}
+	hole := LinearRing{{2, 2}, {4, 2}, {4, 4}, {2, 4}, {2, 2}}
+
+	p, err := NewPolygonPreservingOrientation(LinearRings{slices.Clone(clockwise), hole})
+	require.NoError(t, err)
+	assert.Equal(t, clockwise, p.OuterRing(), "winding order is preserved")
+	assert.False(t, p.IsRightHandRule())
+
+	p, err = NewPolygon(LinearRings{slices.Clone(clockwise), slices.Clone(hole)})
+	require.NoError(t, err)
+	assert.True(t, p.IsRightHandRule())
+
+	_, err = NewPolygonPreservingOrientation(LinearRings{{{0, 0}, {1, 0}, {1, 1}, {0, 1}}})
+	assert.ErrorIs(t, err, ErrLinearRingClosed)
+}
+
+func TestPolygon_IsRightHandRule(t *testing.T) {
+	ccw := LinearRing{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	cw := LinearRing{{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}}
+
+	tests := []struct {
+		name     string
+		rings    LinearRings
+		expected bool
+	}{
+		{"counterclockwise outer ring", LinearRings{ccw}, true},
+		{"clockwise hole", LinearRings{ccw, cw}, true},
+		{"clockwise outer ring", LinearRings{cw}, false},
+		{"counterclockwise hole", LinearRings{ccw, ccw}, false},
+		{"no rings", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Polygon{rings: tt.rings}
+			assert.Equal(t, tt.expected, p.IsRightHandRule())
+		})
+	}
+}
+
 func TestMustPolygon(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -435,3 +478,372 @@ func TestPolygon_InnerRings(t *testing.T) {
 		})
 	}
 }
+
+func TestPolygon_Concavity(t *testing.T) {
+	tests := []struct {
+		name     string
+		polygon  *Polygon
+		expected float64
+	}{
+		{
+			name: "convex polygon",
+			polygon: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}),
+			}),
+			expected: 0,
+		},
+		{
+			name: "L-shaped polygon",
+			polygon: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{0, 0}, {4, 0}, {4, 2}, {2, 2}, {2, 4}, {0, 4}, {0, 0}}),
+			}),
+			expected: 1 - 12.0/14.0,
+		},
+		{
+			name: "convex polygon with hole",
+			polygon: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}),
+				*MustLinearRing(Vertices{{1, 1}, {3, 1}, {3, 3}, {1, 3}, {1, 1}}),
+			}),
+			expected: 0.25,
+		},
+		{
+			name: "degenerate polygon",
+			polygon: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{0, 0}, {1, 1}, {2, 2}, {0, 0}}),
+			}),
+			expected: 0,
+		},
+		{
+			name:     "empty polygon",
+			polygon:  &Polygon{},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, tt.polygon.Concavity(), 1e-9)
+		})
+	}
+}
+
+func TestPolygon_Area(t *testing.T) {
+	tests := []struct {
+		name     string
+		polygon  *Polygon
+		expected float64
+	}{
+		{
+			name: "without holes",
+			polygon: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}),
+			}),
+			expected: 16,
+		},
+		{
+			name: "with holes",
+			polygon: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}),
+				*MustLinearRing(Vertices{{1, 1}, {2, 1}, {2, 2}, {1, 2}, {1, 1}}),
+				*MustLinearRing(Vertices{{3, 3}, {3.5, 3}, {3.5, 3.5}, {3, 3.5}, {3, 3}}),
+			}),
+			expected: 14.75,
+		},
+		{
+			name: "holes exceeding the outer ring",
+			polygon: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}),
+				*MustLinearRing(Vertices{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}),
+			}),
+			expected: 0,
+		},
+		{
+			name:     "empty polygon",
+			polygon:  &Polygon{},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, tt.polygon.Area(), 1e-9)
+		})
+	}
+}
+
+func TestPolygon_GeodesicArea(t *testing.T) {
+	outer := MustLinearRing(Vertices{{0, -10}, {2, -10}, {2, 10}, {0, 10}, {0, -10}})
+	hole := MustLinearRing(Vertices{{0.5, 0}, {1.5, 0}, {1.5, 1}, {0.5, 1}, {0.5, 0}})
+
+	p := MustPolygon(LinearRings{*outer, *hole})
+	assert.InDelta(t, outer.GeodesicArea()-hole.GeodesicArea(), p.GeodesicArea(), 1e-3)
+	assert.Zero(t, (&Polygon{}).GeodesicArea())
+}
+
+func TestPolygon_CachedBoundingBox(t *testing.T) {
+	p := MustPolygon(LinearRings{*MustLinearRing(Vertices{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}})})
+
+	bbox := p.CachedBoundingBox()
+	assert.Equal(t, BoundingBox{0, 0, 4, 4}, bbox)
+	bbox[0] = 99
+	assert.Equal(t, BoundingBox{0, 0, 4, 4}, p.CachedBoundingBox())
+
+	p.rings[0][1][0] = 8
+	assert.Equal(t, BoundingBox{0, 0, 4, 4}, p.CachedBoundingBox())
+	p.InvalidateBBox()
+	assert.Equal(t, BoundingBox{0, 0, 8, 4}, p.CachedBoundingBox())
+
+	require.NoError(t, p.SetOuterRing(LinearRing{{-1, -1}, {1, -1}, {1, 1}, {-1, -1}}))
+	assert.Equal(t, BoundingBox{-1, -1, 1, 1}, p.CachedBoundingBox())
+
+	require.NoError(t, p.AddInnerRing(LinearRing{{0, 0}, {0, 0.5}, {0.5, 0.5}, {0, 0}}))
+	assert.Equal(t, p.BoundingBox(), p.CachedBoundingBox())
+
+	g := p.AsGeometryObject()
+	require.NoError(t, g.MapCoordinates(func(c Coordinates) Coordinates {
+		return Coordinates{c[0] + 10, c[1]}
+	}))
+	assert.Equal(t, BoundingBox{9, -1, 11, 1}, p.CachedBoundingBox())
+
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"Polygon","coordinates":[[[0,0],[2,0],[2,2],[0,0]]]}`), p))
+	assert.Equal(t, BoundingBox{0, 0, 2, 2}, p.CachedBoundingBox())
+}
+
+func TestPolygon_Perimeter(t *testing.T) {
+	outer := Vertices{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}
+	hole := Vertices{{0.25, 0.25}, {0.75, 0.25}, {0.75, 0.75}, {0.25, 0.75}, {0.25, 0.25}}
+
+	p := MustPolygon(LinearRings{*MustLinearRing(outer), *MustLinearRing(hole)})
+	assert.InDelta(t, verticesLength(outer)+verticesLength(hole), p.Perimeter(), 1e-6)
+	assert.InDelta(t, 444780, MustPolygon(LinearRings{*MustLinearRing(outer)}).Perimeter(), 100)
+	assert.Zero(t, (&Polygon{}).Perimeter())
+}
+
+func TestPolygon_Contains(t *testing.T) {
+	square := MustPolygon(LinearRings{
+		*MustLinearRing(Vertices{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}),
+		*MustLinearRing(Vertices{{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}),
+	})
+	concave := MustPolygon(LinearRings{
+		*MustLinearRing(Vertices{{0, 0}, {4, 0}, {4, 2}, {2, 2}, {2, 4}, {0, 4}, {0, 0}}),
+	})
+	clockwise := &Polygon{rings: LinearRings{{{0, 0}, {0, 10}, {10, 10}, {10, 0}, {0, 0}}}}
+
+	tests := []struct {
+		name     string
+		polygon  *Polygon
+		point    *Point
+		expected bool
+	}{
+		{"inside", square, MustPoint([]float64{2, 2}), true},
+		{"outside", square, MustPoint([]float64{12, 2}), false},
+		{"inside hole", square, MustPoint([]float64{5, 5}), false},
+		{"on outer edge", square, MustPoint([]float64{10, 5}), true},
+		{"on outer vertex", square, MustPoint([]float64{0, 0}), true},
+		{"on hole edge", square, MustPoint([]float64{4, 5}), true},
+		{"ray through vertex", square, MustPoint([]float64{2, 10}), true},
+		{"concave notch", concave, MustPoint([]float64{3, 3}), false},
+		{"concave arm", concave, MustPoint([]float64{1, 3}), true},
+		{"clockwise ring", clockwise, MustPoint([]float64{5, 5}), true},
+		{"empty polygon", &Polygon{}, MustPoint([]float64{0, 0}), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.polygon.Contains(*tt.point))
+		})
+	}
+}
+
+func TestPolygon_ContainsLineString(t *testing.T) {
+	square := MustPolygon(LinearRings{
+		*MustLinearRing(Vertices{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}),
+		*MustLinearRing(Vertices{{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}),
+	})
+	concave := MustPolygon(LinearRings{
+		*MustLinearRing(Vertices{{0, 0}, {4, 0}, {4, 2}, {2, 2}, {2, 4}, {0, 4}, {0, 0}}),
+	})
+
+	tests := []struct {
+		name       string
+		polygon    *Polygon
+		lineString *LineString
+		expected   bool
+	}{
+		{"inside", square, MustLineString(Vertices{{1, 1}, {3, 1}, {3, 3}}), true},
+		{"vertex outside", square, MustLineString(Vertices{{1, 1}, {12, 1}}), false},
+		{"across the hole", square, MustLineString(Vertices{{2, 5}, {8, 5}}), false},
+		{"around the hole", square, MustLineString(Vertices{{2, 2}, {8, 2}, {8, 8}}), true},
+		{"along the boundary", square, MustLineString(Vertices{{0, 0}, {10, 0}, {10, 10}}), true},
+		{"across a notch", concave, MustLineString(Vertices{{3, 1}, {1, 3}}), true},
+		{"through a notch", concave, MustLineString(Vertices{{3.5, 1}, {1, 3.5}}), false},
+		{"touching the notch vertex", concave, MustLineString(Vertices{{4, 0}, {0, 4}}), true},
+		{"notch between vertices on the boundary", concave, MustLineString(Vertices{{4, 1}, {1, 4}}), false},
+		{"between notch vertices", concave, MustLineString(Vertices{{4, 2}, {2, 4}}), false},
+		{"along the notch", concave, MustLineString(Vertices{{4, 2}, {2, 2}, {2, 4}}), true},
+		{"empty line string", square, &LineString{}, false},
+		{"empty polygon", &Polygon{}, MustLineString(Vertices{{0, 0}, {1, 1}}), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.polygon.ContainsLineString(tt.lineString))
+		})
+	}
+}
+
+func TestPolygon_SharedBoundaryLength(t *testing.T) {
+	square := MustPolygon(LinearRings{
+		*MustLinearRing(Vertices{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}),
+	})
+	east, north := Coordinates{1, 0}, Coordinates{0, 1}
+	edgeLength := east.Distance(Coordinates{1, 1})
+
+	tests := []struct {
+		name     string
+		other    *Polygon
+		expected float64
+	}{
+		{
+			name: "one shared edge",
+			other: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{1, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 0}}),
+			}),
+			expected: edgeLength,
+		},
+		{
+			name: "two shared edges",
+			other: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{1, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 1}, {1, 1}, {1, 0}}),
+			}),
+			expected: edgeLength + north.Distance(Coordinates{1, 1}),
+		},
+		{
+			name: "touching at a vertex only",
+			other: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{1, 1}, {2, 1}, {2, 2}, {1, 2}, {1, 1}}),
+			}),
+			expected: 0,
+		},
+		{
+			name: "collinear edges with different vertices",
+			other: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{1, 0.5}, {2, 0.5}, {2, 1.5}, {1, 1.5}, {1, 0.5}}),
+			}),
+			expected: 0,
+		},
+		{
+			name:     "nil polygon",
+			other:    nil,
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, square.SharedBoundaryLength(tt.other), 1e-6)
+		})
+	}
+}
+
+func TestPolygon_Simplify(t *testing.T) {
+	tests := []struct {
+		name      string
+		polygon   *Polygon
+		tolerance float64
+		expected  LinearRings
+	}{
+		{
+			name: "drops vertices along edges",
+			polygon: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{0, 0}, {2, 0.01}, {4, 0}, {4, 2}, {4.01, 4}, {0, 4}, {0, 0}}),
+			}),
+			tolerance: 0.1,
+			expected:  LinearRings{{{0, 0}, {4, 0}, {4.01, 4}, {0, 4}, {0, 0}}},
+		},
+		{
+			name: "keeps at least four positions",
+			polygon: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{0, 0}, {1, 0}, {2, 0.1}, {1, 0.2}, {0, 0}}),
+				*MustLinearRing(Vertices{{0.5, 0.05}, {1, 0.1}, {1.5, 0.07}, {1, 0.05}, {0.5, 0.05}}),
+			}),
+			tolerance: 10,
+			expected: LinearRings{
+				{{0, 0}, {2, 0.1}, {1, 0.2}, {0, 0}},
+				{{0.5, 0.05}, {1, 0.1}, {1.5, 0.07}, {0.5, 0.05}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			simplified := tt.polygon.Simplify(tt.tolerance)
+			assert.Equal(t, tt.expected, simplified.LinearRings())
+			for _, ring := range simplified.LinearRings() {
+				assert.True(t, ring.IsValid())
+			}
+		})
+	}
+}
+
+func TestPolygon_SetOuterRing_AddInnerRing(t *testing.T) {
+	var p Polygon
+	assert.ErrorIs(t, p.AddInnerRing(*MustLinearRing(Vertices{{1, 1}, {2, 1}, {2, 2}, {1, 1}})), ErrPolygonLinearRingCount)
+
+	// A clockwise outer ring is reversed, without modifying the caller's ring.
+	outer := *MustLinearRing(Vertices{{0, 0}, {0, 4}, {4, 4}, {4, 0}, {0, 0}})
+	require.NoError(t, p.SetOuterRing(outer))
+	assert.Equal(t, LinearRing{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}, p.OuterRing())
+	assert.Equal(t, LinearRing{{0, 0}, {0, 4}, {4, 4}, {4, 0}, {0, 0}}, outer)
+
+	// A counterclockwise hole is reversed.
+	require.NoError(t, p.AddInnerRing(*MustLinearRing(Vertices{{1, 1}, {2, 1}, {2, 2}, {1, 1}})))
+	assert.Equal(t, LinearRings{{{1, 1}, {2, 2}, {2, 1}, {1, 1}}}, p.InnerRings())
+
+	require.NoError(t, p.SetOuterRing(*MustLinearRing(Vertices{{0, 0}, {5, 0}, {5, 5}, {0, 0}})))
+	assert.Equal(t, LinearRing{{0, 0}, {5, 0}, {5, 5}, {0, 0}}, p.OuterRing())
+	assert.Len(t, p.InnerRings(), 1)
+
+	assert.ErrorIs(t, p.SetOuterRing(LinearRing{{0, 0}, {1, 0}, {0, 0}}), ErrLinearRingSize)
+	assert.ErrorIs(t, p.AddInnerRing(LinearRing{{1, 1}, {2, 1}, {2, 2}, {1, 2}}), ErrLinearRingClosed)
+	assert.Len(t, p.LinearRings(), 2)
+}
+
+func TestPolygon_Subsample(t *testing.T) {
+	p := MustPolygon(LinearRings{
+		{{0, 0}, {1, 0}, {2, 0}, {2, 1}, {2, 2}, {1, 2}, {0, 2}, {0, 1}, {0, 0}},
+		{{0.5, 0.5}, {0.5, 1}, {1, 1}, {0.5, 0.5}},
+	})
+
+	subsampled := p.Subsample(2)
+	assert.Equal(t, LinearRings{
+		{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}},
+		{{0.5, 0.5}, {0.5, 1}, {1, 1}, {0.5, 0.5}},
+	}, subsampled.LinearRings())
+
+	// The step is reduced so that the outer ring keeps 3 distinct positions.
+	subsampled = p.Subsample(5)
+	assert.Equal(t, LinearRing{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}, subsampled.OuterRing())
+	assert.True(t, subsampled.IsRightHandRule())
+
+	assert.Equal(t, p.LinearRings(), p.Subsample(1).LinearRings())
+	assert.Len(t, p.OuterRing(), 9)
+}
+
+func TestPolygon_Densify(t *testing.T) {
+	p := MustPolygon(LinearRings{
+		{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}},
+		{{0.5, 0.5}, {0.5, 1}, {1, 1}, {0.5, 0.5}},
+	})
+
+	densified := p.Densify(1)
+	assert.Equal(t, LinearRings{
+		{{0, 0}, {1, 0}, {2, 0}, {2, 1}, {2, 2}, {1, 2}, {0, 2}, {0, 1}, {0, 0}},
+		{{0.5, 0.5}, {0.5, 1}, {1, 1}, {0.5, 0.5}},
+	}, densified.LinearRings())
+	outer := densified.OuterRing()
+	assert.True(t, outer.IsClosed())
+	assert.Equal(t, p.Area(), densified.Area())
+	assert.Len(t, p.OuterRing(), 5)
+}
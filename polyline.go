@@ -0,0 +1,136 @@
+package geojson
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+const (
+	// PolylineDefaultPrecision defines the number of decimal digits used by the standard
+	// Google Encoded Polyline Algorithm.
+	PolylineDefaultPrecision = 5
+
+	// polylineChunkSize is the number of bits carried by each encoded character.
+	polylineChunkSize = 5
+	// polylineChunkMask masks the bits carried by each encoded character.
+	polylineChunkMask = 0x1f
+	// polylineContinuation flags a character that is followed by another chunk of the same value.
+	polylineContinuation = 0x20
+	// polylineOffset is added to each chunk to obtain a printable ASCII character.
+	polylineOffset = 63
+)
+
+var (
+	// ErrInvalidPolyline is returned when an encoded polyline string is malformed.
+	ErrInvalidPolyline = errors.New("invalid encoded polyline")
+)
+
+// ToPolyline encodes the vertices of the LineString using the Google Encoded Polyline Algorithm.
+// The precision is the number of decimal digits preserved, usually 5 or 6; non-positive values
+// fall back to PolylineDefaultPrecision. Positions are encoded as latitude, longitude pairs
+// as required by the algorithm, and altitude values are ignored.
+func (l *LineString) ToPolyline(precision int) string {
+	factor := polylineFactor(precision)
+
+	var sb strings.Builder
+	var prevLat, prevLng int64
+	for _, v := range l.vertices {
+		lat := int64(math.Round(v.Latitude() * factor))
+		lng := int64(math.Round(v.Longitude() * factor))
+
+		encodePolylineValue(&sb, lat-prevLat)
+		encodePolylineValue(&sb, lng-prevLng)
+
+		prevLat, prevLng = lat, lng
+	}
+
+	return sb.String()
+}
+
+// DecodePolyline decodes a string produced by the Google Encoded Polyline Algorithm into a LineString.
+// The precision must match the one used to encode the string; non-positive values fall back to
+// PolylineDefaultPrecision. Returns ErrInvalidPolyline if the string is malformed, a coordinates error
+// if a decoded position is out of range, or ErrLineStringTooShort if fewer than 2 positions are decoded.
+func DecodePolyline(s string, precision int) (*LineString, error) {
+	factor := polylineFactor(precision)
+
+	var vertices Vertices
+	var lat, lng int64
+	for i := 0; i < len(s); {
+		dLat, n, err := decodePolylineValue(s[i:])
+		if err != nil {
+			return nil, err
+		}
+		i += n
+
+		dLng, n, err := decodePolylineValue(s[i:])
+		if err != nil {
+			return nil, err
+		}
+		i += n
+
+		lat += dLat
+		lng += dLng
+
+		coords, err := NewCoordinates([]float64{float64(lng) / factor, float64(lat) / factor})
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode polyline position %d: %w", len(vertices), err)
+		}
+
+		vertices = append(vertices, *coords)
+	}
+
+	return NewLineString(vertices)
+}
+
+// polylineFactor returns the scale factor for the given precision.
+func polylineFactor(precision int) float64 {
+	if precision <= 0 {
+		precision = PolylineDefaultPrecision
+	}
+
+	return math.Pow10(precision)
+}
+
+// encodePolylineValue appends a signed value to the builder as a sequence of 5-bit chunks.
+func encodePolylineValue(sb *strings.Builder, v int64) {
+	// Left-shift the value and invert it when negative, so that the sign ends up in the lowest bit.
+	u := uint64(v) << 1
+	if v < 0 {
+		u = ^u
+	}
+
+	for u >= polylineContinuation {
+		sb.WriteByte(byte((u&polylineChunkMask)|polylineContinuation) + polylineOffset)
+		u >>= polylineChunkSize
+	}
+	sb.WriteByte(byte(u) + polylineOffset)
+}
+
+// decodePolylineValue reads a signed value from the beginning of the string,
+// returning the value and the number of bytes consumed.
+func decodePolylineValue(s string) (int64, int, error) {
+	var u uint64
+	var shift uint
+	for i := 0; i < len(s); i++ {
+		c := int(s[i]) - polylineOffset
+		if c < 0 || c >= 2*polylineContinuation || shift > 60 {
+			return 0, 0, ErrInvalidPolyline
+		}
+
+		u |= uint64(c&polylineChunkMask) << shift
+		shift += polylineChunkSize
+
+		if c&polylineContinuation == 0 {
+			v := int64(u >> 1)
+			if u&1 != 0 {
+				v = ^v
+			}
+			return v, i + 1, nil
+		}
+	}
+
+	return 0, 0, ErrInvalidPolyline
+}
@@ -0,0 +1,124 @@
+package geojson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineString_ToPolyline(t *testing.T) {
+	tests := []struct {
+		name       string
+		lineString *LineString
+		precision  int
+		expected   string
+	}{
+		{
+			name:       "reference example",
+			lineString: MustLineString(Vertices{{-120.2, 38.5}, {-120.95, 40.7}, {-126.453, 43.252}}),
+			precision:  5,
+			expected:   "_p~iF~ps|U_ulLnnqC_mqNvxq`@",
+		},
+		{
+			name:       "default precision",
+			lineString: MustLineString(Vertices{{-120.2, 38.5}, {-120.95, 40.7}, {-126.453, 43.252}}),
+			precision:  0,
+			expected:   "_p~iF~ps|U_ulLnnqC_mqNvxq`@",
+		},
+		{
+			name:       "altitude is ignored",
+			lineString: MustLineString(Vertices{{-120.2, 38.5, 100}, {-120.95, 40.7, 200}, {-126.453, 43.252, 300}}),
+			precision:  5,
+			expected:   "_p~iF~ps|U_ulLnnqC_mqNvxq`@",
+		},
+		{
+			name:       "empty line string",
+			lineString: &LineString{},
+			precision:  5,
+			expected:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.lineString.ToPolyline(tt.precision))
+		})
+	}
+}
+
+func TestDecodePolyline(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		precision   int
+		expected    Vertices
+		expectedErr error
+	}{
+		{
+			name:      "reference example",
+			input:     "_p~iF~ps|U_ulLnnqC_mqNvxq`@",
+			precision: 5,
+			expected:  Vertices{{-120.2, 38.5}, {-120.95, 40.7}, {-126.453, 43.252}},
+		},
+		{
+			name:        "single position",
+			input:       "_p~iF~ps|U",
+			precision:   5,
+			expectedErr: ErrLineStringTooShort,
+		},
+		{
+			name:        "truncated string",
+			input:       "_p~iF~ps|U_ulL",
+			precision:   5,
+			expectedErr: ErrInvalidPolyline,
+		},
+		{
+			name:        "invalid character",
+			input:       "_p~iF~ps|U !",
+			precision:   5,
+			expectedErr: ErrInvalidPolyline,
+		},
+		{
+			name:        "coordinates out of range",
+			input:       "_p~iF~ps|U_ulLnnqC_mqNvxq`@",
+			precision:   4,
+			expectedErr: ErrLongitudeRange,
+		},
+		{
+			name:        "empty string",
+			input:       "",
+			precision:   5,
+			expectedErr: ErrLineStringTooShort,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := DecodePolyline(tt.input, tt.precision)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, l)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, l.Vertices(), len(tt.expected))
+			for i, v := range l.Vertices() {
+				assert.InDelta(t, tt.expected[i].Longitude(), v.Longitude(), 1e-9)
+				assert.InDelta(t, tt.expected[i].Latitude(), v.Latitude(), 1e-9)
+			}
+		})
+	}
+}
+
+func TestPolyline_RoundTrip(t *testing.T) {
+	l := MustLineString(Vertices{{12.492373, 41.890251}, {12.476879, 41.898614}, {-0.127758, 51.507351}})
+
+	decoded, err := DecodePolyline(l.ToPolyline(6), 6)
+	require.NoError(t, err)
+	for i, v := range decoded.Vertices() {
+		assert.InDelta(t, l.Vertices()[i].Longitude(), v.Longitude(), 1e-9)
+		assert.InDelta(t, l.Vertices()[i].Latitude(), v.Latitude(), 1e-9)
+	}
+}
@@ -3,6 +3,10 @@ package geojson
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
 )
 
 // Error definitions for operations on the Properties type.
@@ -13,6 +17,8 @@ var (
 	ErrInvalidInt       = errors.New("property is not an integer")
 	ErrInvalidFloat     = errors.New("property is not a float")
 	ErrInvalidBool      = errors.New("property is not a boolean")
+	ErrInvalidSlice     = errors.New("property is not an array of strings")
+	ErrInvalidMap       = errors.New("property is not an object")
 )
 
 // Properties represents a map of key-value pairs used as metadata for a GeoJSON feature.
@@ -36,6 +42,32 @@ func (p *Properties) Set(key string, value interface{}) error {
 	return nil
 }
 
+// Merge copies the keys of other and their values into the Properties map, initializing it if nil. Keys that
+// already exist are overwritten if overwrite is true, and kept otherwise. Values are copied shallowly, so nested
+// maps and slices are shared. Returns ErrKeyEmpty, without modifying the map, if other holds an empty key.
+func (p *Properties) Merge(other Properties, overwrite bool) error {
+	if _, ok := other[""]; ok {
+		return ErrKeyEmpty
+	}
+
+	if len(other) == 0 {
+		return nil
+	}
+
+	if *p == nil {
+		*p = make(map[string]interface{}, len(other))
+	}
+
+	for key, value := range other {
+		if _, exists := (*p)[key]; exists && !overwrite {
+			continue
+		}
+		(*p)[key] = value
+	}
+
+	return nil
+}
+
 // Get fetches the value associated with a key in the Properties map.
 // Returns the value and a boolean indicating whether the key exists.
 func (p *Properties) Get(key string) (interface{}, bool) {
@@ -47,6 +79,32 @@ func (p *Properties) Get(key string) (interface{}, bool) {
 	return value, ok
 }
 
+// Delete removes the key and its value from the Properties map.
+// Deleting a missing key, or from a nil map, is a no-op.
+func (p *Properties) Delete(key string) {
+	if p == nil {
+		return
+	}
+
+	delete(*p, key)
+}
+
+// Keys returns the keys of the Properties map in ascending order.
+// Returns an empty slice if the map is nil or empty.
+func (p *Properties) Keys() []string {
+	if p == nil {
+		return []string{}
+	}
+
+	keys := make([]string, 0, len(*p))
+	for key := range *p {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
 // GetString retrieves the value for the given key as a string.
 // Returns an error if the key does not exist or the value is not a string.
 func (p *Properties) GetString(key string) (string, error) {
@@ -68,6 +126,8 @@ func (p *Properties) GetString(key string) (string, error) {
 }
 
 // GetInt retrieves the value for the given key as an integer.
+// The value may be an int or an int64, as set programmatically, or a float64 or json.Number,
+// as decoded from JSON, as long as it has no fractional part and fits in an int.
 // Returns an error if the key does not exist or the value is not an integer.
 func (p *Properties) GetInt(key string) (int, error) {
 	if p == nil || len(*p) == 0 {
@@ -79,15 +139,16 @@ func (p *Properties) GetInt(key string) (int, error) {
 		return 0, ErrPropertyNotFound
 	}
 
-	intValue, ok := value.(float64)
+	intValue, ok := toInt(value)
 	if !ok {
 		return 0, ErrInvalidInt
 	}
 
-	return int(intValue), nil
+	return intValue, nil
 }
 
 // GetFloat retrieves the value for the given key as a float64.
+// The value may be a float64 or a json.Number, which is converted to the nearest float64.
 // Returns an error if the key does not exist or the value is not a float64.
 func (p *Properties) GetFloat(key string) (float64, error) {
 	if p == nil || len(*p) == 0 {
@@ -99,12 +160,18 @@ func (p *Properties) GetFloat(key string) (float64, error) {
 		return 0, ErrPropertyNotFound
 	}
 
-	floatValue, ok := value.(float64)
-	if !ok {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case json.Number:
+		floatValue, err := v.Float64()
+		if err != nil {
+			return 0, ErrInvalidFloat
+		}
+		return floatValue, nil
+	default:
 		return 0, ErrInvalidFloat
 	}
-
-	return floatValue, nil
 }
 
 // GetBool retrieves the value for the given key as a boolean.
@@ -127,6 +194,58 @@ func (p *Properties) GetBool(key string) (bool, error) {
 	return boolValue, nil
 }
 
+// GetStringSlice retrieves the value for the given key as a slice of strings.
+// The value may be a []string or, as decoded from JSON, a []interface{} holding only strings;
+// the returned slice is a copy. Returns an error if the key does not exist or the value is not an array of strings.
+func (p *Properties) GetStringSlice(key string) ([]string, error) {
+	if p == nil || len(*p) == 0 {
+		return nil, ErrPropertyNotFound
+	}
+
+	value, ok := (*p)[key]
+	if !ok {
+		return nil, ErrPropertyNotFound
+	}
+
+	switch v := value.(type) {
+	case []string:
+		return slices.Clone(v), nil
+	case []interface{}:
+		sliceValue := make([]string, len(v))
+		for i, item := range v {
+			if sliceValue[i], ok = item.(string); !ok {
+				return nil, ErrInvalidSlice
+			}
+		}
+		return sliceValue, nil
+	default:
+		return nil, ErrInvalidSlice
+	}
+}
+
+// GetMap retrieves the value for the given key as a nested object.
+// The value may be a map[string]interface{}, as decoded from JSON, or a Properties map.
+// Returns an error if the key does not exist or the value is not an object.
+func (p *Properties) GetMap(key string) (map[string]interface{}, error) {
+	if p == nil || len(*p) == 0 {
+		return nil, ErrPropertyNotFound
+	}
+
+	value, ok := (*p)[key]
+	if !ok {
+		return nil, ErrPropertyNotFound
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return v, nil
+	case Properties:
+		return v, nil
+	default:
+		return nil, ErrInvalidMap
+	}
+}
+
 // MarshalJSON converts the Properties map to a JSON-encoded byte slice.
 // Serializes to null if the map is nil or empty.
 func (p *Properties) MarshalJSON() ([]byte, error) {
@@ -147,3 +266,95 @@ func (p *Properties) UnmarshalJSON(data []byte) error {
 
 	return nil
 }
+
+// PropertiesFromStruct returns the Properties holding the fields of v, encoded as json.Marshal does, so that
+// json struct tags apply, including the "-" and omitempty options. Values are converted to the types of decoded
+// GeoJSON properties, such as float64 for numbers and map[string]interface{} for nested structs. A nil value
+// gives nil Properties. Returns an error wrapping ErrInvalidProperties if v is not encoded as a JSON object,
+// or the error of json.Marshal.
+func PropertiesFromStruct(v interface{}) (Properties, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("%w: got %T", ErrInvalidProperties, v)
+	}
+
+	return raw, nil
+}
+
+// ToStruct stores the Properties in the value pointed to by out, decoded as json.Unmarshal does, so that json
+// struct tags apply and properties without a matching field are ignored. Returns the error of json.Unmarshal,
+// such as a *json.InvalidUnmarshalError if out is not a non-nil pointer, or a *json.UnmarshalTypeError if
+// a property does not fit the type of its field.
+func (p *Properties) ToStruct(out interface{}) error {
+	var m map[string]interface{}
+	if p != nil {
+		m = *p
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, out)
+}
+
+// toInt converts an integral numeric value to an int, reporting whether the conversion succeeded.
+func toInt(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case int64:
+		if v < math.MinInt || v > math.MaxInt {
+			return 0, false
+		}
+		return int(v), true
+	case float64:
+		if v != math.Trunc(v) || v < math.MinInt || v >= math.MaxInt {
+			return 0, false
+		}
+		return int(v), true
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return toInt(i)
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return 0, false
+		}
+		return toInt(f)
+	default:
+		return 0, false
+	}
+}
+
+// filter returns a new Properties map holding the entries whose keys are listed in keys if keep is true,
+// or the entries whose keys are not listed if keep is false. A nil map is returned unchanged.
+func (p Properties) filter(keys []string, keep bool) Properties {
+	if p == nil {
+		return nil
+	}
+
+	filtered := make(Properties, len(p))
+	for key, value := range p {
+		if slices.Contains(keys, key) == keep {
+			filtered[key] = value
+		}
+	}
+
+	return filtered
+}
+
+// equalProperties reports whether two Properties maps hold deeply equal values, treating nil and empty maps as equal.
+func equalProperties(a, b Properties) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+
+	return reflect.DeepEqual(a, b)
+}
@@ -2,6 +2,7 @@ package geojson
 
 import (
 	"encoding/json"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -36,6 +37,31 @@ func TestProperties_Set(t *testing.T) {
 	}
 }
 
+func TestProperties_Merge(t *testing.T) {
+	tests := []struct {
+		name      string
+		p         Properties
+		other     Properties
+		overwrite bool
+		want      Properties
+		wantErr   error
+	}{
+		{"skip existing keys", Properties{"a": 1, "b": 2}, Properties{"b": 3, "c": 4}, false, Properties{"a": 1, "b": 2, "c": 4}, nil},
+		{"overwrite existing keys", Properties{"a": 1, "b": 2}, Properties{"b": 3, "c": 4}, true, Properties{"a": 1, "b": 3, "c": 4}, nil},
+		{"nil map", nil, Properties{"a": 1}, false, Properties{"a": 1}, nil},
+		{"nil other", Properties{"a": 1}, nil, true, Properties{"a": 1}, nil},
+		{"empty key", Properties{"a": 1}, Properties{"": 0, "b": 2}, true, Properties{"a": 1}, ErrKeyEmpty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.p.Merge(tt.other, tt.overwrite)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, tt.p)
+		})
+	}
+}
+
 func TestProperties_Get(t *testing.T) {
 	p := Properties{"key1": "value1", "key2": nil}
 
@@ -59,6 +85,40 @@ func TestProperties_Get(t *testing.T) {
 	}
 }
 
+func TestProperties_Delete(t *testing.T) {
+	p := Properties{"key1": "value1", "key2": 2.0}
+
+	p.Delete("key1")
+	assert.Equal(t, Properties{"key2": 2.0}, p)
+
+	p.Delete("missing")
+	assert.Equal(t, Properties{"key2": 2.0}, p)
+
+	var empty Properties
+	assert.NotPanics(t, func() { empty.Delete("key1") })
+
+	var nilPointer *Properties
+	assert.NotPanics(t, func() { nilPointer.Delete("key1") })
+}
+
+func TestProperties_Keys(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties Properties
+		expected   []string
+	}{
+		{"sorted keys", Properties{"b": 1.0, "c": nil, "a": "x"}, []string{"a", "b", "c"}},
+		{"empty map", Properties{}, []string{}},
+		{"nil map", nil, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.properties.Keys())
+		})
+	}
+}
+
 func TestProperties_GetString(t *testing.T) {
 	p := Properties{"key1": "value1", "key2": 123, "key3": nil}
 
@@ -84,7 +144,20 @@ func TestProperties_GetString(t *testing.T) {
 }
 
 func TestProperties_GetInt(t *testing.T) {
-	p := Properties{"key1": 123.0, "key2": "value1", "key3": nil}
+	p := Properties{
+		"key1":  123.0,
+		"key2":  "value1",
+		"key3":  nil,
+		"key4":  5,
+		"key5":  int64(-7),
+		"key6":  json.Number("42"),
+		"key7":  json.Number("8.0"),
+		"key8":  1.5,
+		"key9":  json.Number("2.5"),
+		"key10": math.NaN(),
+		"key11": math.Inf(1),
+		"key12": true,
+	}
 
 	tests := []struct {
 		name      string
@@ -93,9 +166,18 @@ func TestProperties_GetInt(t *testing.T) {
 		wantError error
 	}{
 		{"existing int key", "key1", 123, nil},
-		{"non-existing key", "key4", 0, ErrPropertyNotFound},
+		{"non-existing key", "key0", 0, ErrPropertyNotFound},
 		{"key with non-int value", "key2", 0, ErrInvalidInt},
 		{"key with nil value", "key3", 0, ErrInvalidInt},
+		{"go int value", "key4", 5, nil},
+		{"go int64 value", "key5", -7, nil},
+		{"json number value", "key6", 42, nil},
+		{"json number with zero fraction", "key7", 8, nil},
+		{"fractional float value", "key8", 0, ErrInvalidInt},
+		{"fractional json number", "key9", 0, ErrInvalidInt},
+		{"NaN value", "key10", 0, ErrInvalidInt},
+		{"infinite value", "key11", 0, ErrInvalidInt},
+		{"key with bool value", "key12", 0, ErrInvalidInt},
 	}
 
 	for _, tt := range tests {
@@ -108,7 +190,13 @@ func TestProperties_GetInt(t *testing.T) {
 }
 
 func TestProperties_GetFloat(t *testing.T) {
-	p := Properties{"key1": 123.45, "key2": "value1", "key3": nil}
+	p := Properties{
+		"key1": 123.45,
+		"key2": "value1",
+		"key3": nil,
+		"key5": json.Number("6.5"),
+		"key6": json.Number("invalid"),
+	}
 
 	tests := []struct {
 		name      string
@@ -120,6 +208,8 @@ func TestProperties_GetFloat(t *testing.T) {
 		{"non-existing key", "key4", 0, ErrPropertyNotFound},
 		{"key with non-float value", "key2", 0, ErrInvalidFloat},
 		{"key with nil value", "key3", 0, ErrInvalidFloat},
+		{"json number value", "key5", 6.5, nil},
+		{"malformed json number", "key6", 0, ErrInvalidFloat},
 	}
 
 	for _, tt := range tests {
@@ -155,6 +245,68 @@ func TestProperties_GetBool(t *testing.T) {
 	}
 }
 
+func TestProperties_GetStringSlice(t *testing.T) {
+	p := Properties{
+		"key1": []interface{}{"a", "b"},
+		"key2": []string{"c"},
+		"key3": []interface{}{"a", 1.0},
+		"key4": "value1",
+		"key5": []interface{}{},
+	}
+
+	tests := []struct {
+		name      string
+		key       string
+		wantSlice []string
+		wantError error
+	}{
+		{"decoded string array", "key1", []string{"a", "b"}, nil},
+		{"string slice", "key2", []string{"c"}, nil},
+		{"non-existing key", "key6", nil, ErrPropertyNotFound},
+		{"array with non-string items", "key3", nil, ErrInvalidSlice},
+		{"key with non-array value", "key4", nil, ErrInvalidSlice},
+		{"empty array", "key5", []string{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSlice, err := p.GetStringSlice(tt.key)
+			assert.ErrorIs(t, err, tt.wantError)
+			assert.Equal(t, tt.wantSlice, gotSlice)
+		})
+	}
+}
+
+func TestProperties_GetMap(t *testing.T) {
+	p := Properties{
+		"key1": map[string]interface{}{"a": 1.0},
+		"key2": Properties{"b": "c"},
+		"key3": []interface{}{"a"},
+		"key4": nil,
+	}
+
+	tests := []struct {
+		name      string
+		key       string
+		wantMap   map[string]interface{}
+		wantError error
+	}{
+		{"decoded object", "key1", map[string]interface{}{"a": 1.0}, nil},
+		{"nested properties", "key2", map[string]interface{}{"b": "c"}, nil},
+		{"non-existing key", "key5", nil, ErrPropertyNotFound},
+		{"key with non-object value", "key3", nil, ErrInvalidMap},
+		{"key with nil value", "key4", nil, ErrInvalidMap},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMap, err := p.GetMap(tt.key)
+			assert.ErrorIs(t, err, tt.wantError)
+			assert.Equal(t, tt.wantMap, gotMap)
+		})
+	}
+}
+
 func TestProperties_GetFromNil(t *testing.T) {
 	t.Run("Get methods for all types with non-existing key and uninitialized map", func(t *testing.T) {
 		var p Properties
@@ -232,3 +384,89 @@ func TestProperties_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestPropertiesFromStruct(t *testing.T) {
+	type address struct {
+		City string `json:"city"`
+	}
+	type attributes struct {
+		Name     string   `json:"name"`
+		Lanes    int      `json:"lanes"`
+		Tags     []string `json:"tags,omitempty"`
+		Internal string   `json:"-"`
+		Address  address  `json:"address"`
+		Untagged bool
+	}
+
+	tests := []struct {
+		name    string
+		v       interface{}
+		want    Properties
+		wantErr error
+	}{
+		{
+			name: "struct",
+			v:    attributes{Name: "Main St", Lanes: 2, Internal: "x", Address: address{City: "Rome"}},
+			want: Properties{
+				"name":     "Main St",
+				"lanes":    2.0,
+				"address":  map[string]interface{}{"city": "Rome"},
+				"Untagged": false,
+			},
+		},
+		{
+			name: "pointer to struct",
+			v:    &attributes{Tags: []string{"a"}},
+			want: Properties{
+				"name":     "",
+				"lanes":    0.0,
+				"tags":     []interface{}{"a"},
+				"address":  map[string]interface{}{"city": ""},
+				"Untagged": false,
+			},
+		},
+		{name: "nil", v: nil, want: nil},
+		{name: "not an object", v: []int{1}, wantErr: ErrInvalidProperties},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PropertiesFromStruct(tt.v)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := PropertiesFromStruct(map[string]interface{}{"f": func() {}})
+	assert.Error(t, err)
+}
+
+func TestProperties_ToStruct(t *testing.T) {
+	type attributes struct {
+		Name  string  `json:"name"`
+		Lanes int     `json:"lanes"`
+		Speed float64 `json:"speed,omitempty"`
+		Skip  string  `json:"-"`
+	}
+
+	p := Properties{"name": "Main St", "lanes": 2.0, "Skip": "x", "other": true}
+
+	var out attributes
+	require.NoError(t, p.ToStruct(&out))
+	assert.Equal(t, attributes{Name: "Main St", Lanes: 2}, out)
+
+	var invalid *json.InvalidUnmarshalError
+	assert.ErrorAs(t, p.ToStruct(out), &invalid, "non-pointer out")
+
+	var mismatch *json.UnmarshalTypeError
+	assert.ErrorAs(t, (&Properties{"lanes": "two"}).ToStruct(&out), &mismatch)
+
+	var empty Properties
+	out = attributes{Name: "kept"}
+	require.NoError(t, empty.ToStruct(&out))
+	assert.Equal(t, "kept", out.Name)
+}
@@ -0,0 +1,116 @@
+package geojson
+
+import (
+	"slices"
+)
+
+// Quantize snaps the longitude and latitude of every position of the geometry, in place, to the nearest
+// multiple of gridSize degrees, keeping altitudes, then removes the consecutive positions that became equal.
+// It returns the number of positions removed, including those of the parts dropped as described below.
+// A non-positive gridSize, or an empty object, leaves the geometry unchanged.
+//
+// Parts that collapse are handled as follows:
+//   - rings left with fewer than 4 positions are dropped; when the outer ring of a polygon collapses,
+//     its holes are dropped with it, so a Polygon may be left without rings and a MultiPolygon loses
+//     that polygon.
+//   - line strings of a MultiLineString left with a single position are dropped, while a LineString
+//     collapsing to a single position keeps it twice, so that it remains valid.
+//
+// Snapping may make rings self-intersecting or change their orientation when they are small compared
+// to the grid; LinearRing.IsSimple and Polygon.IsRightHandRule can be used to check the result.
+func (g *GeometryObject) Quantize(gridSize float64) int {
+	if g.IsEmpty() || gridSize <= 0 {
+		return 0
+	}
+
+	removed := quantizeGeometry(g.geometry, gridSize)
+	invalidateBBoxes(g.geometry)
+
+	return removed
+}
+
+// quantizeGeometry snaps the positions of the geometry to the grid in place, recursing into the children
+// of a GeometryCollection, and returns the number of positions removed.
+func quantizeGeometry(g Geometry, gridSize float64) int {
+	switch v := g.(type) {
+	case *Point:
+		v.coords = snapVertices(Vertices{v.coords}, gridSize)[0]
+		return 0
+	case *MultiPoint:
+		n := len(v.vertices)
+		v.vertices = snapVertices(v.vertices, gridSize)
+		return n - len(v.vertices)
+	case *LineString:
+		n := len(v.vertices)
+		snapped := snapVertices(v.vertices, gridSize)
+		if len(snapped) == 1 {
+			snapped = append(snapped, slices.Clone(snapped[0]))
+		}
+		v.vertices = snapped
+		return n - len(snapped)
+	case *MultiLineString:
+		removed := 0
+		segments := make(Segments, 0, len(v.segments))
+		for _, s := range v.segments {
+			removed += len(s)
+			snapped := snapVertices(s, gridSize)
+			if len(snapped) < LineStringMinimumSize {
+				continue
+			}
+			removed -= len(snapped)
+			segments = append(segments, snapped)
+		}
+		v.segments = segments
+		return removed
+	case *Polygon:
+		rings, removed := quantizeRings(v.rings, gridSize)
+		v.rings = rings
+		return removed
+	case *MultiPolygon:
+		removed := 0
+		slice := make([]LinearRings, 0, len(v.rings))
+		for _, rings := range v.rings {
+			quantized, n := quantizeRings(rings, gridSize)
+			removed += n
+			if len(quantized) > 0 {
+				slice = append(slice, quantized)
+			}
+		}
+		v.rings = slice
+		return removed
+	case *GeometryCollection:
+		removed := 0
+		for _, child := range v.geometries {
+			removed += quantizeGeometry(child, gridSize)
+		}
+		return removed
+	default:
+		return 0
+	}
+}
+
+// quantizeRings returns the rings of a polygon snapped to the grid, without the rings that collapse,
+// and the number of positions removed. It returns no rings when the outer ring collapses.
+func quantizeRings(rings LinearRings, gridSize float64) (LinearRings, int) {
+	removed := 0
+	var out LinearRings
+	for i, ring := range rings {
+		snapped := LinearRing(snapVertices(Vertices(ring), gridSize))
+		if snapped.HasValidSize() {
+			removed += len(ring) - len(snapped)
+			out = append(out, snapped)
+			continue
+		}
+
+		if i == 0 {
+			total := 0
+			for _, r := range rings {
+				total += len(r)
+			}
+			return nil, total
+		}
+		removed += len(ring)
+	}
+
+	return out, removed
+}
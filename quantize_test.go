@@ -0,0 +1,117 @@
+package geojson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeometryObject_Quantize(t *testing.T) {
+	tests := []struct {
+		name        string
+		geometry    Geometry
+		gridSize    float64
+		expected    Geometry
+		wantRemoved int
+	}{
+		{
+			name:     "point",
+			geometry: &Point{coords: Coordinates{1.26, 2.74, 10.33}},
+			gridSize: 0.5,
+			expected: &Point{coords: Coordinates{1.5, 2.5, 10.33}},
+		},
+		{
+			name:        "line string",
+			geometry:    &LineString{vertices: Vertices{{0, 0}, {0.1, 0.1}, {0.9, 1.1}, {2, 2}}},
+			gridSize:    1,
+			expected:    &LineString{vertices: Vertices{{0, 0}, {1, 1}, {2, 2}}},
+			wantRemoved: 1,
+		},
+		{
+			name:        "collapsed line string",
+			geometry:    &LineString{vertices: Vertices{{0, 0}, {0.1, 0.1}, {0.2, 0}}},
+			gridSize:    1,
+			expected:    &LineString{vertices: Vertices{{0, 0}, {0, 0}}},
+			wantRemoved: 1,
+		},
+		{
+			name:        "multi line string",
+			geometry:    &MultiLineString{segments: Segments{{{0, 0}, {0.1, 0.1}}, {{0, 0}, {1.2, 0.9}}}},
+			gridSize:    1,
+			expected:    &MultiLineString{segments: Segments{{{0, 0}, {1, 1}}}},
+			wantRemoved: 2,
+		},
+		{
+			name: "polygon with a collapsed hole",
+			geometry: &Polygon{rings: LinearRings{
+				{{0, 0}, {10.2, 0}, {10, 0.1}, {10, 9.8}, {0, 10}, {0, 0}},
+				{{4, 4}, {4.2, 4.1}, {4.1, 4.3}, {4, 4}},
+			}},
+			gridSize: 1,
+			expected: &Polygon{rings: LinearRings{
+				{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+			}},
+			wantRemoved: 5,
+		},
+		{
+			name: "multi polygon with a collapsed polygon",
+			geometry: &MultiPolygon{rings: []LinearRings{
+				{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}},
+				{
+					{{8, 8}, {8.2, 8}, {8.2, 8.2}, {8, 8.2}, {8, 8}},
+					{{8.05, 8.05}, {8.05, 8.1}, {8.1, 8.1}, {8.05, 8.05}},
+				},
+			}},
+			gridSize:    1,
+			expected:    &MultiPolygon{rings: []LinearRings{{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}}}},
+			wantRemoved: 9,
+		},
+		{
+			name: "geometry collection",
+			geometry: &GeometryCollection{geometries: []Geometry{
+				&MultiPoint{vertices: Vertices{{0.1, 0}, {0, 0.1}, {3, 3}}},
+				&Point{coords: Coordinates{0.4, 0.6}},
+			}},
+			gridSize: 1,
+			expected: &GeometryCollection{geometries: []Geometry{
+				&MultiPoint{vertices: Vertices{{0, 0}, {3, 3}}},
+				&Point{coords: Coordinates{0, 1}},
+			}},
+			wantRemoved: 1,
+		},
+		{
+			name:     "non-positive grid size",
+			geometry: &LineString{vertices: Vertices{{0, 0}, {0.1, 0.1}}},
+			gridSize: 0,
+			expected: &LineString{vertices: Vertices{{0, 0}, {0.1, 0.1}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := FromGeometry(tt.geometry)
+			assert.Equal(t, tt.wantRemoved, g.Quantize(tt.gridSize))
+			assert.True(t, GeometriesEqual(tt.expected, g.geometry), "got %v", g.geometry)
+		})
+	}
+
+	t.Run("outer ring collapses", func(t *testing.T) {
+		p := &Polygon{rings: LinearRings{{{0, 0}, {0.2, 0}, {0.2, 0.2}, {0, 0.2}, {0, 0}}}}
+		g := FromGeometry(p)
+		assert.Equal(t, 5, g.Quantize(1))
+		assert.Empty(t, p.LinearRings())
+	})
+
+	t.Run("bounding box", func(t *testing.T) {
+		p := MustPolygon(LinearRings{{{0.1, 0.1}, {3.9, 0.1}, {3.9, 3.9}, {0.1, 3.9}, {0.1, 0.1}}})
+		require.Equal(t, BoundingBox{0.1, 0.1, 3.9, 3.9}, p.CachedBoundingBox())
+
+		g := FromGeometry(p)
+		g.Quantize(1)
+		assert.Equal(t, BoundingBox{0, 0, 4, 4}, p.CachedBoundingBox())
+	})
+
+	var empty GeometryObject
+	assert.Zero(t, empty.Quantize(1))
+}
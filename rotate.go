@@ -0,0 +1,128 @@
+package geojson
+
+import "math"
+
+// planarRotation rotates positions about an origin in a local planar approximation,
+// where longitude differences are scaled by the cosine of the origin latitude.
+type planarRotation struct {
+	origin   Coordinates
+	sin, cos float64
+	scale    float64
+}
+
+// newPlanarRotation creates a planarRotation of angleDeg degrees counterclockwise about origin.
+func newPlanarRotation(angleDeg float64, origin Coordinates) planarRotation {
+	sin, cos := math.Sincos(degreesToRadians(angleDeg))
+
+	// Near the poles a degree of longitude shrinks to nothing, so fall back to plain degrees.
+	scale := math.Cos(degreesToRadians(origin.Latitude()))
+	if scale < 1e-12 {
+		scale = 1
+	}
+
+	return planarRotation{origin: origin, sin: sin, cos: cos, scale: scale}
+}
+
+// apply rotates a single position in place, preserving its altitude.
+func (r planarRotation) apply(c Coordinates) {
+	x := (c[idxCoordsLng] - r.origin[idxCoordsLng]) * r.scale
+	y := c[idxCoordsLat] - r.origin[idxCoordsLat]
+
+	c[idxCoordsLng] = r.origin[idxCoordsLng] + (x*r.cos-y*r.sin)/r.scale
+	c[idxCoordsLat] = r.origin[idxCoordsLat] + x*r.sin + y*r.cos
+}
+
+// rotated returns a rotated copy of a single position, preserving its altitude.
+func (r planarRotation) rotated(c Coordinates) Coordinates {
+	return transformed(c, r.apply)
+}
+
+// applyVertices replaces each of a sequence of positions with a rotated copy, so that positions sharing
+// their values, such as the first and closing positions of a ring, are rotated only once.
+func (r planarRotation) applyVertices(v Vertices) {
+	for i, c := range v {
+		v[i] = r.rotated(c)
+	}
+}
+
+// applyLinearRings rotates the positions of each ring in place.
+func (r planarRotation) applyLinearRings(rings LinearRings) {
+	for _, ring := range rings {
+		r.applyVertices(Vertices(ring))
+	}
+}
+
+// applyGeometry rotates the positions of any geometry in place.
+func (r planarRotation) applyGeometry(g Geometry) {
+	switch v := g.(type) {
+	case *Point:
+		v.coords = r.rotated(v.coords)
+	case *LineString:
+		r.applyVertices(v.vertices)
+	case *MultiPoint:
+		r.applyVertices(v.vertices)
+	case *MultiLineString:
+		for _, segment := range v.segments {
+			r.applyVertices(segment)
+		}
+	case *Polygon:
+		r.applyLinearRings(v.rings)
+		v.InvalidateBBox()
+	case *MultiPolygon:
+		for _, rings := range v.rings {
+			r.applyLinearRings(rings)
+		}
+		v.InvalidateBBox()
+	case *GeometryCollection:
+		for _, child := range v.geometries {
+			r.applyGeometry(child)
+		}
+	}
+}
+
+// Rotate rotates the Point by angleDeg degrees counterclockwise about origin, in place.
+// See Polygon.Rotate for details on the approximation used.
+func (p *Point) Rotate(angleDeg float64, origin Coordinates) {
+	newPlanarRotation(angleDeg, origin).applyGeometry(p)
+}
+
+// Rotate rotates the LineString by angleDeg degrees counterclockwise about origin, in place.
+// See Polygon.Rotate for details on the approximation used.
+func (l *LineString) Rotate(angleDeg float64, origin Coordinates) {
+	newPlanarRotation(angleDeg, origin).applyGeometry(l)
+}
+
+// Rotate rotates the MultiPoint by angleDeg degrees counterclockwise about origin, in place.
+// See Polygon.Rotate for details on the approximation used.
+func (m *MultiPoint) Rotate(angleDeg float64, origin Coordinates) {
+	newPlanarRotation(angleDeg, origin).applyGeometry(m)
+}
+
+// Rotate rotates the MultiLineString by angleDeg degrees counterclockwise about origin, in place.
+// See Polygon.Rotate for details on the approximation used.
+func (m *MultiLineString) Rotate(angleDeg float64, origin Coordinates) {
+	newPlanarRotation(angleDeg, origin).applyGeometry(m)
+}
+
+// Rotate rotates the Polygon by angleDeg degrees counterclockwise about origin, in place.
+//
+// The rotation is an approximate planar one, not geodesic: longitude differences are scaled by the
+// cosine of the origin latitude so that shapes keep their proportions near the origin, which works well
+// for small geometries such as building footprints. Altitude values are preserved, ring orientation is
+// unchanged, and the rotated positions are not validated, so large rotations near the poles or the
+// antimeridian may produce out-of-range coordinates.
+func (p *Polygon) Rotate(angleDeg float64, origin Coordinates) {
+	newPlanarRotation(angleDeg, origin).applyGeometry(p)
+}
+
+// Rotate rotates the MultiPolygon by angleDeg degrees counterclockwise about origin, in place.
+// See Polygon.Rotate for details on the approximation used.
+func (m *MultiPolygon) Rotate(angleDeg float64, origin Coordinates) {
+	newPlanarRotation(angleDeg, origin).applyGeometry(m)
+}
+
+// Rotate rotates every geometry of the GeometryCollection by angleDeg degrees counterclockwise
+// about origin, in place. See Polygon.Rotate for details on the approximation used.
+func (g *GeometryCollection) Rotate(angleDeg float64, origin Coordinates) {
+	newPlanarRotation(angleDeg, origin).applyGeometry(g)
+}
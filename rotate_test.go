@@ -0,0 +1,121 @@
+package geojson
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func assertVerticesInDelta(t *testing.T, expected, actual Vertices) {
+	t.Helper()
+
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		require.Len(t, actual[i], len(expected[i]))
+		for j := range expected[i] {
+			assert.InDelta(t, expected[i][j], actual[i][j], 1e-9)
+		}
+	}
+}
+
+// sharedClosingRing returns the ring of a square whose closing position is the same slice as its first one.
+func sharedClosingRing() LinearRing {
+	first := Coordinates{-1, -1}
+	return LinearRing{first, {1, -1}, {1, 1}, {-1, 1}, first}
+}
+
+func TestGeometry_Rotate(t *testing.T) {
+	origin := Coordinates{0, 0}
+	cos60 := math.Cos(degreesToRadians(60))
+
+	tests := []struct {
+		name     string
+		geometry interface {
+			Geometry
+			Rotate(angleDeg float64, origin Coordinates)
+		}
+		angle    float64
+		origin   Coordinates
+		expected Vertices
+	}{
+		{
+			name:     "point quarter turn",
+			geometry: MustPoint([]float64{1, 0}),
+			angle:    90,
+			origin:   origin,
+			expected: Vertices{{0, 1}},
+		},
+		{
+			name:     "altitude is preserved",
+			geometry: MustPoint([]float64{1, 0, 25}),
+			angle:    180,
+			origin:   origin,
+			expected: Vertices{{-1, 0, 25}},
+		},
+		{
+			name:     "line string clockwise",
+			geometry: MustLineString(Vertices{{0, 0}, {0, 1}}),
+			angle:    -90,
+			origin:   origin,
+			expected: Vertices{{0, 0}, {1, 0}},
+		},
+		{
+			name:     "longitude scaled at origin latitude",
+			geometry: NewMultiPointFromVertices(Vertices{{11, 60}}),
+			angle:    90,
+			origin:   Coordinates{10, 60},
+			expected: Vertices{{10, 60 + cos60}},
+		},
+		{
+			name:     "multi line string",
+			geometry: MustMultiLineString(Segments{{{1, 1}, {2, 1}}}),
+			angle:    180,
+			origin:   Coordinates{1, 1},
+			expected: Vertices{{1, 1}, {0, 1}},
+		},
+		{
+			name: "polygon",
+			geometry: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}),
+			}),
+			angle:    90,
+			origin:   origin,
+			expected: Vertices{{0, 0}, {0, 1}, {-1, 1}, {-1, 0}, {0, 0}},
+		},
+		{
+			name:     "ring closed by its first position",
+			geometry: &Polygon{rings: LinearRings{sharedClosingRing()}},
+			angle:    90,
+			origin:   origin,
+			expected: Vertices{{1, -1}, {1, 1}, {-1, 1}, {-1, -1}, {1, -1}},
+		},
+		{
+			name: "multi polygon",
+			geometry: MustMultiPolygonFromRingSlice([]LinearRings{{
+				*MustLinearRing(Vertices{{0, 0}, {1, 0}, {1, 1}, {0, 0}}),
+			}}),
+			angle:    360,
+			origin:   Coordinates{5, 5},
+			expected: Vertices{{0, 0}, {1, 0}, {1, 1}, {0, 0}},
+		},
+		{
+			name: "geometry collection",
+			geometry: NewGeometryCollectionFromSlice([]Geometry{
+				MustPoint([]float64{1, 0}),
+				MustLineString(Vertices{{0, 1}, {0, 2}}),
+			}),
+			angle:    90,
+			origin:   origin,
+			expected: Vertices{{0, 1}, {-1, 0}, {-2, 0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.geometry.Rotate(tt.angle, tt.origin)
+			assertVerticesInDelta(t, tt.expected, tt.geometry.Vertices())
+		})
+	}
+}
@@ -0,0 +1,282 @@
+package geojson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+const (
+	// topologyType is the type of a TopoJSON topology object.
+	topologyType = "Topology"
+)
+
+var (
+	// ErrInvalidTopology is returned when TopoJSON data is malformed or references missing arcs.
+	ErrInvalidTopology = errors.New("invalid TopoJSON topology")
+)
+
+// DecodeTopoJSON decodes a TopoJSON topology into a FeatureCollection. The objects of the topology are taken
+// in ascending order of name: a GeometryCollection object contributes a feature for each of its geometries,
+// any other object a single feature. Points, LineStrings, Polygons, their multi variants, and nested
+// GeometryCollections are supported, and null geometries become features without a geometry. The id and
+// properties of each geometry object become those of its feature.
+//
+// Arcs are stitched into lines and rings, reversing those referenced by a negative index, and quantized
+// topologies are decoded by undoing the delta encoding of arcs and applying the transform to arc and point
+// positions. Values past the longitude and latitude are kept unchanged. The resulting positions are validated
+// like those of any other geometry, and polygon rings are oriented following the right-hand rule.
+// It returns an error wrapping ErrInvalidTopology for malformed topologies.
+func DecodeTopoJSON(data []byte) (*FeatureCollection, error) {
+	var input topologyJSONInput
+	if err := json.Unmarshal(data, &input); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTopology, err)
+	}
+
+	if input.Type != topologyType {
+		return nil, fmt.Errorf("%w: unexpected type %q", ErrInvalidTopology, input.Type)
+	}
+
+	d := &topologyDecoder{transform: input.Transform}
+	if d.transform != nil && (len(d.transform.Scale) != 2 || len(d.transform.Translate) != 2) {
+		return nil, fmt.Errorf("%w: transform must have a scale and a translate of 2 values", ErrInvalidTopology)
+	}
+
+	d.arcs = make([]Vertices, len(input.Arcs))
+	for i, arc := range input.Arcs {
+		positions, err := d.decodeArc(arc)
+		if err != nil {
+			return nil, fmt.Errorf("%w: arc %d: %w", ErrInvalidTopology, i, err)
+		}
+		d.arcs[i] = positions
+	}
+
+	names := make([]string, 0, len(input.Objects))
+	for name := range input.Objects {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	fc := NewFeatureCollection()
+	fc.Features = []Feature{}
+	for _, name := range names {
+		object := input.Objects[name]
+
+		objects := []topologyObjectJSONInput{object}
+		if object.Type == string(TypeGeometryCollection) {
+			objects = object.Geometries
+		}
+
+		for i, o := range objects {
+			geometry, err := d.geometry(o)
+			if err != nil {
+				return nil, fmt.Errorf("%w: object %q, geometry %d: %w", ErrInvalidTopology, name, i, err)
+			}
+
+			fc.Features = append(fc.Features, Feature{
+				Geometry:   geometry,
+				Properties: o.Properties,
+				ID:         o.ID,
+			})
+		}
+	}
+
+	return fc, nil
+}
+
+// topologyDecoder converts the geometry objects of a topology into geometries.
+type topologyDecoder struct {
+	transform *topologyTransformJSONInput // transform is the quantization transform, if any.
+	arcs      []Vertices                  // arcs holds the decoded positions of each arc.
+}
+
+// decodeArc returns the absolute positions of an arc, undoing the delta encoding of quantized topologies.
+func (d *topologyDecoder) decodeArc(arc [][]float64) (Vertices, error) {
+	positions := make(Vertices, len(arc))
+
+	var x, y float64
+	for i, p := range arc {
+		if len(p) < coordsMinLen {
+			return nil, fmt.Errorf("position %d has %d values", i, len(p))
+		}
+
+		if d.transform == nil {
+			positions[i] = slices.Clone(p)
+			continue
+		}
+
+		x += p[idxCoordsLng]
+		y += p[idxCoordsLat]
+		positions[i] = d.position(append([]float64{x, y}, p[coordsMinLen:]...))
+	}
+
+	return positions, nil
+}
+
+// position applies the transform, if any, to the longitude and latitude of a quantized position in place.
+func (d *topologyDecoder) position(p []float64) Coordinates {
+	if d.transform != nil {
+		p[idxCoordsLng] = p[idxCoordsLng]*d.transform.Scale[0] + d.transform.Translate[0]
+		p[idxCoordsLat] = p[idxCoordsLat]*d.transform.Scale[1] + d.transform.Translate[1]
+	}
+	return p
+}
+
+// geometry converts a geometry object into a Geometry, returning nil for null geometries.
+func (d *topologyDecoder) geometry(o topologyObjectJSONInput) (Geometry, error) {
+	switch GeometryType(o.Type) {
+	case "":
+		return nil, nil
+	case TypePoint:
+		var p []float64
+		if err := json.Unmarshal(o.Coordinates, &p); err != nil {
+			return nil, err
+		}
+		positions, err := d.points([][]float64{p})
+		if err != nil {
+			return nil, err
+		}
+		return &Point{coords: positions[0]}, nil
+	case TypeMultiPoint:
+		var p [][]float64
+		if err := json.Unmarshal(o.Coordinates, &p); err != nil {
+			return nil, err
+		}
+		positions, err := d.points(p)
+		if err != nil {
+			return nil, err
+		}
+		return NewMultiPointFromVertices(positions), nil
+	case TypeLineString:
+		var arcs []int
+		if err := json.Unmarshal(o.Arcs, &arcs); err != nil {
+			return nil, err
+		}
+		line, err := d.line(arcs)
+		if err != nil {
+			return nil, err
+		}
+		return NewLineString(line)
+	case TypeMultiLineString:
+		var arcs [][]int
+		if err := json.Unmarshal(o.Arcs, &arcs); err != nil {
+			return nil, err
+		}
+		segments, err := d.lines(arcs)
+		if err != nil {
+			return nil, err
+		}
+		return NewMultiLineString(segments)
+	case TypePolygon:
+		var arcs [][]int
+		if err := json.Unmarshal(o.Arcs, &arcs); err != nil {
+			return nil, err
+		}
+		rings, err := d.rings(arcs)
+		if err != nil {
+			return nil, err
+		}
+		return NewPolygon(rings)
+	case TypeMultiPolygon:
+		var arcs [][][]int
+		if err := json.Unmarshal(o.Arcs, &arcs); err != nil {
+			return nil, err
+		}
+		slice := make([]LinearRings, len(arcs))
+		for i, polygon := range arcs {
+			rings, err := d.rings(polygon)
+			if err != nil {
+				return nil, err
+			}
+			slice[i] = rings
+		}
+		return NewMultiPolygonFromRingSlice(slice)
+	case TypeGeometryCollection:
+		geometries := make([]Geometry, 0, len(o.Geometries))
+		for _, child := range o.Geometries {
+			g, err := d.geometry(child)
+			if err != nil {
+				return nil, err
+			}
+			if g != nil {
+				geometries = append(geometries, g)
+			}
+		}
+		return NewGeometryCollectionFromSlice(geometries), nil
+	default:
+		return nil, fmt.Errorf("unsupported geometry type %q", o.Type)
+	}
+}
+
+// points validates and transforms the positions of a Point or MultiPoint.
+func (d *topologyDecoder) points(p [][]float64) (Vertices, error) {
+	positions := make(Vertices, len(p))
+	for i, position := range p {
+		coords, err := NewCoordinates(d.position(slices.Clone(position)))
+		if err != nil {
+			return nil, err
+		}
+		positions[i] = *coords
+	}
+	return positions, nil
+}
+
+// lines stitches the arcs of each line.
+func (d *topologyDecoder) lines(arcs [][]int) (Segments, error) {
+	segments := make(Segments, len(arcs))
+	for i, line := range arcs {
+		vertices, err := d.line(line)
+		if err != nil {
+			return nil, err
+		}
+		segments[i] = vertices
+	}
+	return segments, nil
+}
+
+// rings stitches the arcs of each ring of a polygon.
+func (d *topologyDecoder) rings(arcs [][]int) (LinearRings, error) {
+	segments, err := d.lines(arcs)
+	if err != nil {
+		return nil, err
+	}
+
+	rings := make(LinearRings, len(segments))
+	for i, s := range segments {
+		rings[i] = LinearRing(s)
+	}
+	return rings, nil
+}
+
+// line stitches the referenced arcs into a sequence of validated positions, reversing the arcs referenced by
+// a negative index and dropping the first position of each arc after the first, which repeats the last one.
+// The positions are copies, so geometries sharing an arc can be modified independently.
+func (d *topologyDecoder) line(arcs []int) (Vertices, error) {
+	var vertices Vertices
+	for i, index := range arcs {
+		reversed := index < 0
+		if reversed {
+			index = ^index
+		}
+		if index >= len(d.arcs) {
+			return nil, fmt.Errorf("arc %d does not exist", index)
+		}
+
+		arc := cloneVertices(d.arcs[index])
+		if reversed {
+			slices.Reverse(arc)
+		}
+		if i > 0 && len(arc) > 0 {
+			arc = arc[1:]
+		}
+
+		for _, p := range arc {
+			if _, err := NewCoordinates(p); err != nil {
+				return nil, err
+			}
+		}
+		vertices = append(vertices, arc...)
+	}
+	return vertices, nil
+}
@@ -0,0 +1,101 @@
+package geojson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeTopoJSON(t *testing.T) {
+	data := `{
+		"type": "Topology",
+		"transform": {"scale": [0.5, 0.5], "translate": [10, 20]},
+		"arcs": [
+			[[0, 0], [2, 0], [0, 2], [-2, 0], [0, -2]],
+			[[0, 0], [4, 0]],
+			[[4, 0], [0, 4]]
+		],
+		"objects": {
+			"b": {"type": "LineString", "id": 5, "properties": {"name": "line"}, "arcs": [1, 2]},
+			"a": {"type": "GeometryCollection", "geometries": [
+				{"type": "Point", "coordinates": [2, 2]},
+				{"type": "MultiPoint", "coordinates": [[0, 0], [4, 4]]},
+				{"type": "MultiLineString", "arcs": [[1], [-3]]},
+				{"type": "Polygon", "id": "square", "arcs": [[0]]},
+				{"type": "MultiPolygon", "arcs": [[[0]], [[-1]]]},
+				{"type": null, "id": "empty"}
+			]}
+		}
+	}`
+
+	fc, err := DecodeTopoJSON([]byte(data))
+	require.NoError(t, err)
+
+	square := LinearRings{{{10, 20}, {11, 20}, {11, 21}, {10, 21}, {10, 20}}}
+	expected := []Feature{
+		{Geometry: MustPoint([]float64{11, 21})},
+		{Geometry: NewMultiPointFromVertices(Vertices{{10, 20}, {12, 22}})},
+		{Geometry: MustMultiLineString(Segments{{{10, 20}, {12, 20}}, {{12, 22}, {12, 20}}})},
+		{Geometry: MustPolygon(square), ID: NewStringID("square")},
+		{Geometry: MustMultiPolygonFromRingSlice([]LinearRings{square, square})},
+		{ID: NewStringID("empty")},
+		{
+			Geometry:   MustLineString(Vertices{{10, 20}, {12, 20}, {12, 22}}),
+			Properties: Properties{"name": "line"},
+			ID:         NewIntID(5),
+		},
+	}
+
+	require.Len(t, fc.Features, len(expected))
+	for i := range expected {
+		assert.Truef(t, expected[i].Equal(&fc.Features[i]), "feature %d: %v", i, fc.Features[i].Geometry)
+	}
+
+	// Geometries sharing an arc hold distinct positions.
+	fc.Features[3].Geometry.(*Polygon).rings[0][1][0] = 99
+	assert.Equal(t, 11.0, fc.Features[4].Geometry.(*MultiPolygon).rings[0][0][1][0])
+}
+
+func TestDecodeTopoJSON_WithoutTransform(t *testing.T) {
+	data := `{
+		"type": "Topology",
+		"arcs": [[[0, 0, 5], [1, 1, 6]], [[1, 1, 6], [2, 0, 7]]],
+		"objects": {"line": {"type": "LineString", "arcs": [0, 1]}}
+	}`
+
+	fc, err := DecodeTopoJSON([]byte(data))
+	require.NoError(t, err)
+	require.Len(t, fc.Features, 1)
+	assert.Equal(t, Vertices{{0, 0, 5}, {1, 1, 6}, {2, 0, 7}}, fc.Features[0].Geometry.Vertices())
+
+	fc, err = DecodeTopoJSON([]byte(`{"type": "Topology", "arcs": [], "objects": {}}`))
+	require.NoError(t, err)
+	assert.Empty(t, fc.Features)
+}
+
+func TestDecodeTopoJSON_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"malformed JSON", `{`},
+		{"wrong type", `{"type": "FeatureCollection", "features": []}`},
+		{"invalid transform", `{"type": "Topology", "transform": {"scale": [1], "translate": [0, 0]}, "arcs": [], "objects": {}}`},
+		{"short arc position", `{"type": "Topology", "arcs": [[[0]]], "objects": {}}`},
+		{"missing arc", `{"type": "Topology", "arcs": [], "objects": {"a": {"type": "LineString", "arcs": [0]}}}`},
+		{"missing reversed arc", `{"type": "Topology", "arcs": [], "objects": {"a": {"type": "LineString", "arcs": [-1]}}}`},
+		{"unsupported type", `{"type": "Topology", "arcs": [], "objects": {"a": {"type": "Circle"}}}`},
+		{"invalid arcs member", `{"type": "Topology", "arcs": [], "objects": {"a": {"type": "Polygon", "arcs": [0]}}}`},
+		{"latitude out of range", `{"type": "Topology", "arcs": [[[0, 0], [0, 100]]], "objects": {"a": {"type": "LineString", "arcs": [0]}}}`},
+		{"open ring", `{"type": "Topology", "arcs": [[[0, 0], [1, 0], [1, 1], [0, 1]]], "objects": {"a": {"type": "Polygon", "arcs": [[0]]}}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fc, err := DecodeTopoJSON([]byte(tt.data))
+			assert.ErrorIs(t, err, ErrInvalidTopology)
+			assert.Nil(t, fc)
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package geojson
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"slices"
+)
+
+var (
+	// ErrCoordinateOutsideRegion is returned when a decoded position lies outside the region
+	// required by UnmarshalOptions.RequireWithinBBox.
+	ErrCoordinateOutsideRegion = errors.New("coordinate outside the required region")
+)
+
+// UnmarshalOptions configures how GeoJSON objects are decoded by UnmarshalOptions.Unmarshal.
+// The zero value behaves like json.Unmarshal.
+type UnmarshalOptions struct {
+	// RequireWithinBBox, when set, rejects any position whose longitude or latitude lies outside
+	// the bounding box, boundary included, as soon as it is decoded. A bounding box whose minimum longitude
+	// exceeds its maximum is taken to cross the antimeridian. Altitude is not checked.
+	RequireWithinBBox *BoundingBox
+
+	// UseNumber, when set, decodes the numbers held by feature properties, including those nested in arrays
+	// and objects, as json.Number instead of float64, preserving the precision of large integers.
+	// Properties.GetInt and Properties.GetFloat accept json.Number values.
+	UseNumber bool
+
+	// AllowMeasure, when set, also accepts positions with a 4th value, the measure of linear referencing
+	// systems, which is not part of RFC 7946. The measure is available through Coordinates.Measure and is
+	// written back when the geometry is encoded. Otherwise positions must have 2 or 3 values.
+	AllowMeasure bool
+
+	// PreserveOrientation, when set, keeps the winding order of polygon rings as decoded instead of
+	// orienting them following the right-hand rule. Polygon.IsRightHandRule reports whether they follow it.
+	PreserveOrientation bool
+
+	// Lenient, when set, accepts non-standard encodings written by some producers of legacy data:
+	//   - the id member of a geometry object decoded into a *GeometryObject is kept, available through
+	//     GeometryObject.ID, and written back when it is encoded; otherwise it is ignored, as RFC 7946
+	//     defines identifiers on features only.
+	//   - the values of positions may be numbers encoded as JSON strings, such as ["1.5","2.5"], which are
+	//     parsed as floating-point numbers; otherwise they are rejected with ErrInvalidCoordinates.
+	Lenient bool
+
+	// NormalizeLongitude, when set, accepts longitudes in the 0 to 360 form used by some datasets, converting
+	// those greater than 180, up to 360, into the -180 to 180 range by subtracting 360. Otherwise they are
+	// rejected with ErrLongitudeRange.
+	NormalizeLongitude bool
+}
+
+// DecodeOption configures the UnmarshalOptions applied by UnmarshalWithOptions. Any function setting the
+// fields of the options can be used, besides the ones provided by the package.
+type DecodeOption func(o *UnmarshalOptions)
+
+// WithLongitudeNormalization returns a DecodeOption that sets UnmarshalOptions.NormalizeLongitude.
+func WithLongitudeNormalization() DecodeOption {
+	return func(o *UnmarshalOptions) {
+		o.NormalizeLongitude = true
+	}
+}
+
+// decodeOptions holds the options applied while decoding geometries, which the UnmarshalJSON methods
+// leave at their zero value.
+type decodeOptions struct {
+	allowMeasure        bool    // allowMeasure accepts positions with a 4th value.
+	preserveOrientation bool    // preserveOrientation keeps the winding order of polygon rings.
+	lenient             bool    // lenient accepts non-standard encodings of legacy data.
+	normalizeLongitude  bool    // normalizeLongitude converts longitudes from 180 to 360 into the -180 to 180 range.
+	region              *region // region, when set, holds the extents every position must lie within.
+}
+
+// region holds the extents of a bounding box that decoded positions must lie within,
+// two of them for a box crossing the antimeridian.
+type region struct {
+	extents []boxExtent
+}
+
+// newRegion creates a region from a 2D or 3D bounding box, splitting it at the antimeridian when its
+// minimum longitude exceeds its maximum. It returns false if the bounding box is neither 2D nor 3D,
+// or if its minimum latitude exceeds its maximum.
+func newRegion(b BoundingBox) (*region, bool) {
+	r := &region{}
+	for _, part := range splitAtAntimeridian(b) {
+		e, ok := newBoxExtent(part)
+		if !ok || e.minLat > e.maxLat {
+			return nil, false
+		}
+		r.extents = append(r.extents, e)
+	}
+
+	return r, true
+}
+
+// contains reports whether the longitude and latitude of the position lie within the region, boundary included.
+func (r *region) contains(c Coordinates) bool {
+	return slices.ContainsFunc(r.extents, func(e boxExtent) bool {
+		return e.contains(c)
+	})
+}
+
+// acceptsPositionSize reports whether a position with n values is accepted.
+func (o decodeOptions) acceptsPositionSize(n int) bool {
+	return n == coordsMinLen || n == coordsMaxLen || (o.allowMeasure && n == coordsMeasureLen)
+}
+
+// Unmarshal decodes GeoJSON data into v applying the options. The checks on positions, RequireWithinBBox,
+// AllowMeasure, PreserveOrientation, Lenient, and NormalizeLongitude apply when v is a Geometry,
+// a *GeometryObject, a *Feature, a *FeatureCollection, or an *Object; other values are decoded without them.
+// UseNumber applies when v is a *Feature, a *FeatureCollection, or an *Object. It returns an error wrapping
+// ErrCoordinateOutsideRegion with the first offending position, in which case v is left unchanged,
+// or ErrInvalidBBox if RequireWithinBBox is malformed.
+func (o *UnmarshalOptions) Unmarshal(data []byte, v interface{}) error {
+	opts, err := o.decodeOptions()
+	if err != nil {
+		return err
+	}
+
+	if err := decodeValue(data, v, opts); err != nil {
+		return err
+	}
+
+	if o.UseNumber {
+		return decodePropertyNumbers(data, v)
+	}
+
+	return nil
+}
+
+// decodeOptions returns the options applied while decoding geometries.
+// It returns ErrInvalidBBox if RequireWithinBBox is malformed.
+func (o *UnmarshalOptions) decodeOptions() (decodeOptions, error) {
+	opts := decodeOptions{
+		allowMeasure:        o.AllowMeasure,
+		preserveOrientation: o.PreserveOrientation,
+		lenient:             o.Lenient,
+		normalizeLongitude:  o.NormalizeLongitude,
+	}
+
+	if o.RequireWithinBBox != nil {
+		r, ok := newRegion(*o.RequireWithinBBox)
+		if !ok {
+			return decodeOptions{}, ErrInvalidBBox
+		}
+		opts.region = r
+	}
+
+	return opts, nil
+}
+
+// decodeValue decodes data into v applying the decoding options to the geometries of GeoJSON values.
+// With the default options, or for any other value, it behaves like json.Unmarshal.
+func decodeValue(data []byte, v interface{}, opts decodeOptions) error {
+	if opts == (decodeOptions{}) {
+		return json.Unmarshal(data, v)
+	}
+
+	switch v := v.(type) {
+	case *GeometryObject:
+		return v.decode(data, opts)
+	case Geometry:
+		return decodeGeometryInto(v, data, opts)
+	case *Feature:
+		return v.decode(data, opts)
+	case *FeatureCollection:
+		return v.decode(data, opts)
+	case *Object:
+		return v.decode(data, opts)
+	default:
+		return json.Unmarshal(data, v)
+	}
+}
+
+// decodePropertyNumbers decodes the properties of the features in data again, keeping numbers as json.Number,
+// and replaces those of the already decoded value v.
+func decodePropertyNumbers(data []byte, v interface{}) error {
+	switch v := v.(type) {
+	case *Feature:
+		return decodeFeatureProperties(data, v)
+	case *FeatureCollection:
+		return decodeFeatureCollectionProperties(data, v)
+	case *Object:
+		switch {
+		case v.feature != nil:
+			return decodeFeatureProperties(data, v.feature)
+		case v.features != nil:
+			return decodeFeatureCollectionProperties(data, v.features)
+		}
+	}
+	return nil
+}
+
+// decodeFeatureProperties replaces the properties of f with those decoded from data, keeping numbers as json.Number.
+func decodeFeatureProperties(data []byte, f *Feature) error {
+	var input featurePropertiesJSONInput
+	if err := decodeWithNumbers(data, &input); err != nil {
+		return err
+	}
+
+	f.Properties = input.Properties
+	return nil
+}
+
+// decodeFeatureCollectionProperties replaces the properties of the features of fc with those decoded
+// from data, keeping numbers as json.Number.
+func decodeFeatureCollectionProperties(data []byte, fc *FeatureCollection) error {
+	var input featureCollectionPropertiesJSONInput
+	if err := decodeWithNumbers(data, &input); err != nil {
+		return err
+	}
+
+	for i := range fc.Features {
+		if i < len(input.Features) {
+			fc.Features[i].Properties = input.Features[i].Properties
+		}
+	}
+	return nil
+}
+
+// decodeWithNumbers decodes data into v, keeping numbers held in interface values as json.Number.
+func decodeWithNumbers(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
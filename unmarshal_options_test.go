@@ -0,0 +1,294 @@
+package geojson
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalOptions_Unmarshal(t *testing.T) {
+	// Approximate extent of the contiguous United States.
+	region := BoundingBox{-125, 24, -66, 50}
+	options := UnmarshalOptions{RequireWithinBBox: &region}
+
+	tests := []struct {
+		name        string
+		data        string
+		value       interface{}
+		expectedErr error
+		errContains string
+	}{
+		{
+			name:  "point inside",
+			data:  `{"type":"Point","coordinates":[-95.7,37.1]}`,
+			value: &Point{},
+		},
+		{
+			name:  "point on the boundary",
+			data:  `{"type":"Point","coordinates":[-66,50,10]}`,
+			value: &Point{},
+		},
+		{
+			name:        "swapped longitude and latitude",
+			data:        `{"type":"LineString","coordinates":[[-95.7,37.1],[37.1,-95.7]]}`,
+			value:       &LineString{},
+			expectedErr: ErrLatitudeRange,
+		},
+		{
+			name:        "wrong hemisphere",
+			data:        `{"type":"Polygon","coordinates":[[[-100,30],[-90,30],[-90,40],[-100,30]],[[95,35],[96,35],[96,36],[95,35]]]}`,
+			value:       &Polygon{},
+			expectedErr: ErrCoordinateOutsideRegion,
+			errContains: "[ 95, 35 ]",
+		},
+		{
+			name:        "geometry object",
+			data:        `{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[-95.7,37.1]},{"type":"Point","coordinates":[0,0]}]}`,
+			value:       &GeometryObject{},
+			expectedErr: ErrCoordinateOutsideRegion,
+			errContains: "[ 0, 0 ]",
+		},
+		{
+			name:        "feature collection",
+			data:        `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[-0.1278,51.5074]},"properties":null}]}`,
+			value:       &FeatureCollection{},
+			expectedErr: ErrCoordinateOutsideRegion,
+		},
+		{
+			name:        "object",
+			data:        `{"type":"Feature","geometry":{"type":"MultiPoint","coordinates":[[-95.7,37.1],[-150,61]]},"properties":null}`,
+			value:       &Object{},
+			expectedErr: ErrCoordinateOutsideRegion,
+		},
+		{
+			name:  "feature without geometry",
+			data:  `{"type":"Feature","geometry":null,"properties":null}`,
+			value: &Feature{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := options.Unmarshal([]byte(tt.data), tt.value)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.ErrorContains(t, err, tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUnmarshalOptions_Unmarshal_RegionFailsFast(t *testing.T) {
+	region := BoundingBox{0, 0, 10, 10}
+	options := UnmarshalOptions{RequireWithinBBox: &region}
+
+	feature := Feature{Geometry: MustPoint([]float64{1, 1}), ID: NewStringID("kept")}
+	err := options.Unmarshal([]byte(`{"type":"Feature","geometry":{"type":"Point","coordinates":[20,20]},"properties":null,"id":"rejected"}`), &feature)
+	assert.ErrorIs(t, err, ErrCoordinateOutsideRegion)
+	assert.Equal(t, Feature{Geometry: MustPoint([]float64{1, 1}), ID: NewStringID("kept")}, feature, "the value is left unchanged")
+}
+
+func TestUnmarshalOptions_Unmarshal_RegionAcrossAntimeridian(t *testing.T) {
+	region := BoundingBox{170, -20, -170, 20}
+	options := UnmarshalOptions{RequireWithinBBox: &region}
+
+	var line LineString
+	require.NoError(t, options.Unmarshal([]byte(`{"type":"LineString","coordinates":[[175,0],[180,5],[-175,10]]}`), &line))
+	assert.Equal(t, Vertices{{175, 0}, {180, 5}, {-175, 10}}, line.Vertices())
+
+	err := options.Unmarshal([]byte(`{"type":"Point","coordinates":[0,0]}`), &Point{})
+	assert.ErrorIs(t, err, ErrCoordinateOutsideRegion)
+
+	err = options.Unmarshal([]byte(`{"type":"Point","coordinates":[175,30]}`), &Point{})
+	assert.ErrorIs(t, err, ErrCoordinateOutsideRegion)
+}
+
+func TestUnmarshalOptions_Unmarshal_NoRegion(t *testing.T) {
+	var point Point
+	require.NoError(t, (&UnmarshalOptions{}).Unmarshal([]byte(`{"type":"Point","coordinates":[0,0]}`), &point))
+	assert.Equal(t, Coordinates{0, 0}, point.Coordinates())
+
+	invalid := BoundingBox{0, 0, 1}
+	options := UnmarshalOptions{RequireWithinBBox: &invalid}
+	assert.ErrorIs(t, options.Unmarshal([]byte(`{"type":"Point","coordinates":[0,0]}`), &point), ErrInvalidBBox)
+}
+
+func TestUnmarshalOptions_Unmarshal_UseNumber(t *testing.T) {
+	options := UnmarshalOptions{UseNumber: true}
+	feature := `{"type":"Feature","geometry":null,"properties":{"id":9007199254740993,"tags":[1.5],"name":"a"}}`
+
+	t.Run("feature", func(t *testing.T) {
+		var f Feature
+		require.NoError(t, options.Unmarshal([]byte(feature), &f))
+		assert.Equal(t, Properties{
+			"id":   json.Number("9007199254740993"),
+			"tags": []interface{}{json.Number("1.5")},
+			"name": "a",
+		}, f.Properties)
+
+		id, err := f.Properties.GetInt("id")
+		require.NoError(t, err)
+		assert.Equal(t, 9007199254740993, id)
+	})
+
+	t.Run("feature collection", func(t *testing.T) {
+		var fc FeatureCollection
+		data := `{"type":"FeatureCollection","features":[` + feature + `,{"type":"Feature","geometry":null,"properties":null}]}`
+		require.NoError(t, options.Unmarshal([]byte(data), &fc))
+		require.Len(t, fc.Features, 2)
+		assert.Equal(t, json.Number("9007199254740993"), fc.Features[0].Properties["id"])
+		assert.Nil(t, fc.Features[1].Properties)
+	})
+
+	t.Run("object", func(t *testing.T) {
+		var o Object
+		require.NoError(t, options.Unmarshal([]byte(feature), &o))
+		f, err := o.Feature()
+		require.NoError(t, err)
+		assert.Equal(t, json.Number("9007199254740993"), f.Properties["id"])
+	})
+
+	t.Run("without option", func(t *testing.T) {
+		var f Feature
+		require.NoError(t, (&UnmarshalOptions{}).Unmarshal([]byte(feature), &f))
+		assert.IsType(t, float64(0), f.Properties["id"])
+	})
+}
+
+func TestUnmarshalOptions_Unmarshal_AllowMeasure(t *testing.T) {
+	options := UnmarshalOptions{AllowMeasure: true}
+
+	t.Run("point", func(t *testing.T) {
+		data := `{"type":"Point","coordinates":[1,2,3,4]}`
+
+		var point Point
+		require.NoError(t, options.Unmarshal([]byte(data), &point))
+		coords := point.Coordinates()
+		m, ok := coords.Measure()
+		assert.True(t, ok)
+		assert.Equal(t, 4.0, m)
+
+		out, err := json.Marshal(&point)
+		require.NoError(t, err)
+		assert.JSONEq(t, data, string(out))
+
+		assert.ErrorIs(t, (&UnmarshalOptions{}).Unmarshal([]byte(data), &point), ErrCoordinatesSize)
+		assert.ErrorIs(t, json.Unmarshal([]byte(data), &point), ErrCoordinatesSize)
+	})
+
+	t.Run("feature collection", func(t *testing.T) {
+		data := `{"type":"FeatureCollection","features":[{"type":"Feature","properties":null,"geometry":
+			{"type":"GeometryCollection","geometries":[{"type":"LineString","coordinates":[[0,0,0,0],[1,1,0,2.5]]}]}}]}`
+
+		var fc FeatureCollection
+		require.NoError(t, options.Unmarshal([]byte(data), &fc))
+		require.Len(t, fc.Features, 1)
+		assert.Equal(t, Vertices{{0, 0, 0, 0}, {1, 1, 0, 2.5}}, fc.Features[0].Geometry.Vertices())
+
+		var o Object
+		require.NoError(t, options.Unmarshal([]byte(data), &o))
+
+		assert.ErrorIs(t, json.Unmarshal([]byte(data), &fc), ErrCoordinatesSize)
+	})
+
+	t.Run("too many values", func(t *testing.T) {
+		var f Feature
+		data := `{"type":"Feature","properties":null,"geometry":{"type":"Point","coordinates":[1,2,3,4,5]}}`
+		assert.ErrorIs(t, options.Unmarshal([]byte(data), &f), ErrCoordinatesSize)
+	})
+}
+
+func TestUnmarshalOptions_Unmarshal_PreserveOrientation(t *testing.T) {
+	data := `{"type":"MultiPolygon","coordinates":[[[[0,0],[0,1],[1,1],[1,0],[0,0]]]]}`
+
+	var m MultiPolygon
+	require.NoError(t, (&UnmarshalOptions{PreserveOrientation: true}).Unmarshal([]byte(data), &m))
+	p := Polygon{rings: m.LinearRingsSlice()[0]}
+	assert.False(t, p.IsRightHandRule())
+
+	out, err := json.Marshal(&m)
+	require.NoError(t, err)
+	assert.JSONEq(t, data, string(out))
+
+	require.NoError(t, json.Unmarshal([]byte(data), &m))
+	p = Polygon{rings: m.LinearRingsSlice()[0]}
+	assert.True(t, p.IsRightHandRule())
+}
+
+func TestUnmarshalOptions_Unmarshal_LenientGeometryID(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   string
+		wantID *ID
+	}{
+		{"string id", `{"type":"Point","coordinates":[1,2],"id":"a"}`, NewStringID("a")},
+		{"integer id", `{"type":"Point","coordinates":[1,2],"id":7}`, NewIntID(7)},
+		{"no id", `{"type":"Point","coordinates":[1,2]}`, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var g GeometryObject
+			require.NoError(t, (&UnmarshalOptions{Lenient: true}).Unmarshal([]byte(tt.data), &g))
+
+			id, ok := g.ID()
+			assert.Equal(t, tt.wantID != nil, ok)
+			assert.True(t, tt.wantID.Equal(id))
+
+			out, err := json.Marshal(&g)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.data, string(out))
+		})
+	}
+
+	var g GeometryObject
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"Point","coordinates":[1,2],"id":"a"}`), &g))
+	_, ok := g.ID()
+	assert.False(t, ok, "the id should be ignored unless decoding leniently")
+
+	out, err := json.Marshal(&g)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"Point","coordinates":[1,2]}`, string(out))
+}
+
+func TestUnmarshalOptions_Unmarshal_LenientNumericStrings(t *testing.T) {
+	options := UnmarshalOptions{Lenient: true}
+
+	tests := []struct {
+		name    string
+		data    string
+		want    Vertices
+		wantErr error
+	}{
+		{"strings", `{"type":"LineString","coordinates":[["1.5","2.5"],["-3","4e1","100"]]}`, Vertices{{1.5, 2.5}, {-3, 40, 100}}, nil},
+		{"mixed", `{"type":"LineString","coordinates":[[1.5,"2.5"],["3",4]]}`, Vertices{{1.5, 2.5}, {3, 4}}, nil},
+		{"not a number", `{"type":"Point","coordinates":["east","2"]}`, nil, ErrInvalidCoordinates},
+		{"not finite", `{"type":"Point","coordinates":["NaN","2"]}`, nil, ErrInvalidCoordinates},
+		{"out of range", `{"type":"Point","coordinates":["181","2"]}`, nil, ErrLongitudeRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var g GeometryObject
+			err := options.Unmarshal([]byte(tt.data), &g)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var got Vertices
+			g.Walk(func(c Coordinates) bool {
+				got = append(got, c)
+				return true
+			})
+			assert.Equal(t, tt.want, got)
+
+			assert.ErrorIs(t, json.Unmarshal([]byte(tt.data), &g), ErrInvalidCoordinates)
+		})
+	}
+}
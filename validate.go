@@ -0,0 +1,305 @@
+package geojson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+var (
+	// ErrInvalidProperties is returned when a properties value cannot be represented as a JSON value.
+	ErrInvalidProperties = errors.New("properties must be a JSON object or null")
+
+	// ErrInvalidFeaturesMember is returned when the features member of a FeatureCollection is not an array.
+	ErrInvalidFeaturesMember = errors.New("features member must be an array")
+
+	// ErrMissingGeometryMember is returned when a decoded Feature has no geometry member, not even a null one.
+	ErrMissingGeometryMember = errors.New("feature must have a geometry member")
+
+	// ErrNonFiniteNumber is returned when a coordinate, bounding box, or ID value is NaN or infinite.
+	ErrNonFiniteNumber = errors.New("number must be finite")
+
+	// ErrBBoxOrder is returned when the southwesterly corner of a bounding box lies above its northeasterly corner.
+	ErrBBoxOrder = errors.New("bounding box minimum latitude or altitude exceeds its maximum")
+
+	// ErrBBoxNotEnclosing is returned when a declared bounding box does not enclose every position it applies to.
+	ErrBBoxNotEnclosing = errors.New("bounding box does not enclose all positions")
+)
+
+// ValidationError describes an RFC 7946 violation found at a specific location of a GeoJSON object.
+type ValidationError struct {
+	Path string // Path locates the offending member, such as "features[2].geometry.coordinates[0]".
+	Err  error  // Err is the underlying violation.
+}
+
+// Error returns the path followed by the description of the violation.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Path, e.Err)
+}
+
+// Unwrap returns the underlying violation.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateRFC7946 checks the Feature against RFC 7946 and returns every violation found, each as a
+// *ValidationError, or nil if the Feature conforms. It checks the geometry, including the size of line strings
+// and rings, ring closure, position ranges, and nested geometry collections, as well as declared bounding boxes
+// and whether they enclose the geometry. It also checks that properties can be encoded as a JSON object,
+// and that the ID is a string or a finite number. A decoded Feature must have had a geometry member.
+// Foreign members are not checked.
+func (f *Feature) ValidateRFC7946() []error {
+	v := &rfc7946Validator{}
+	v.feature("", f)
+
+	return v.errs
+}
+
+// ValidateRFC7946 checks the FeatureCollection and all of its features against RFC 7946 and returns every
+// violation found, each as a *ValidationError, or nil if the FeatureCollection conforms. The declared
+// bounding box of the collection must enclose all of its features.
+// See Feature.ValidateRFC7946 for the checks performed on each feature.
+func (f *FeatureCollection) ValidateRFC7946() []error {
+	v := &rfc7946Validator{}
+
+	if f.featuresMember != FeaturesMemberArray {
+		v.report("features", ErrInvalidFeaturesMember)
+	}
+
+	v.bbox("", f.bbox, f.Walk)
+
+	for i := range f.Features {
+		v.feature(indexPath("features", i), &f.Features[i])
+	}
+
+	return v.errs
+}
+
+// Validate checks the FeatureCollection against RFC 7946 like ValidateRFC7946, and joins every violation
+// found into a single error, or returns nil if the FeatureCollection conforms. Each violation can be
+// inspected with errors.Is and errors.As, and is reported on its own line with its path.
+func (f *FeatureCollection) Validate() error {
+	return errors.Join(f.ValidateRFC7946()...)
+}
+
+// rfc7946Validator collects the RFC 7946 violations found while walking a GeoJSON object.
+type rfc7946Validator struct {
+	errs []error
+}
+
+// report records a violation at the given path.
+func (v *rfc7946Validator) report(path string, err error) {
+	v.errs = append(v.errs, &ValidationError{Path: path, Err: err})
+}
+
+// feature validates the members of a Feature.
+func (v *rfc7946Validator) feature(path string, f *Feature) {
+	if !f.HasGeometryMember() {
+		v.report(memberPath(path, "geometry"), ErrMissingGeometryMember)
+	}
+	if f.Geometry != nil {
+		v.geometry(memberPath(path, "geometry"), f.Geometry)
+	}
+
+	// Visit the properties in key order, so that violations are reported deterministically.
+	keys := make([]string, 0, len(f.Properties))
+	for key := range f.Properties {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		if _, err := json.Marshal(f.Properties[key]); err != nil {
+			v.report(memberPath(memberPath(path, "properties"), key), fmt.Errorf("%w: %v", ErrInvalidProperties, err))
+		}
+	}
+
+	if f.ID != nil {
+		v.id(memberPath(path, "id"), f.ID)
+	}
+}
+
+// id validates that an ID holds a string or a finite number.
+func (v *rfc7946Validator) id(path string, id *ID) {
+	if id.s != nil || id.i != nil {
+		return
+	}
+
+	switch {
+	case id.n == nil:
+		v.report(path, ErrInvalidID)
+	case math.IsNaN(*id.n) || math.IsInf(*id.n, 0):
+		v.report(path, ErrNonFiniteNumber)
+	}
+}
+
+// geometry validates a Geometry, its coordinates, and its declared bounding box.
+func (v *rfc7946Validator) geometry(path string, g Geometry) {
+	coordinatesPath := memberPath(path, "coordinates")
+	walk := func(fn func(c Coordinates) bool) {
+		walkGeometry(g, fn)
+	}
+
+	switch g := g.(type) {
+	case *Point:
+		v.bbox(path, g.bbox, walk)
+		v.position(coordinatesPath, g.coords)
+	case *LineString:
+		v.bbox(path, g.bbox, walk)
+		v.lineString(coordinatesPath, g.vertices)
+	case *MultiPoint:
+		v.bbox(path, g.bbox, walk)
+		v.positions(coordinatesPath, g.vertices)
+	case *MultiLineString:
+		v.bbox(path, g.bbox, walk)
+		for i, segment := range g.segments {
+			v.lineString(indexPath(coordinatesPath, i), segment)
+		}
+	case *Polygon:
+		v.bbox(path, g.bbox, walk)
+		v.polygon(coordinatesPath, g.rings)
+	case *MultiPolygon:
+		v.bbox(path, g.bbox, walk)
+		for i, rings := range g.rings {
+			v.polygon(indexPath(coordinatesPath, i), rings)
+		}
+	case *GeometryCollection:
+		v.bbox(path, g.bbox, walk)
+		for i, gm := range g.geometries {
+			geometryPath := indexPath(memberPath(path, "geometries"), i)
+			switch gm.(type) {
+			case nil:
+				v.report(geometryPath, ErrGeometryNotDefined)
+				continue
+			case *GeometryCollection:
+				v.report(geometryPath, ErrNestedGeometryCollection)
+			}
+			v.geometry(geometryPath, gm)
+		}
+	default:
+		v.report(path, ErrInvalidTypeField)
+	}
+}
+
+// lineString validates the positions of a LineString.
+func (v *rfc7946Validator) lineString(path string, vertices Vertices) {
+	if len(vertices) < LineStringMinimumSize {
+		v.report(path, ErrLineStringTooShort)
+	}
+
+	v.positions(path, vertices)
+}
+
+// polygon validates the linear rings of a Polygon.
+func (v *rfc7946Validator) polygon(path string, rings LinearRings) {
+	if len(rings) == 0 {
+		v.report(path, ErrPolygonLinearRingCount)
+	}
+
+	for i, ring := range rings {
+		ringPath := indexPath(path, i)
+		switch {
+		case !ring.HasValidSize():
+			v.report(ringPath, ErrLinearRingSize)
+		case !ring.IsClosed():
+			v.report(ringPath, ErrLinearRingClosed)
+		}
+
+		v.positions(ringPath, Vertices(ring))
+	}
+}
+
+// positions validates each position of a sequence.
+func (v *rfc7946Validator) positions(path string, vertices Vertices) {
+	for i, c := range vertices {
+		v.position(indexPath(path, i), c)
+	}
+}
+
+// position validates the size, finiteness, and range of a single position.
+func (v *rfc7946Validator) position(path string, c Coordinates) {
+	if len(c) != coordsMinLen && len(c) != coordsMaxLen {
+		v.report(path, ErrCoordinatesSize)
+		return
+	}
+
+	for _, value := range c {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			v.report(path, ErrNonFiniteNumber)
+			return
+		}
+	}
+
+	if err := validateCoordinates(c.Longitude(), c.Latitude()); err != nil {
+		v.report(path, err)
+	}
+}
+
+// bbox validates the bounding box declared on an object, if any, and checks that it encloses the positions
+// of the object, visited by walk. Longitudes are not compared with each other, since a bounding box crossing the antimeridian
+// has a western edge greater than its eastern one.
+func (v *rfc7946Validator) bbox(path string, b BoundingBox, walk func(fn func(c Coordinates) bool)) {
+	if b.IsZero() {
+		return
+	}
+
+	path = memberPath(path, "bbox")
+	if !b.IsValid() {
+		v.report(path, ErrInvalidBBox)
+		return
+	}
+
+	for _, value := range b {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			v.report(path, ErrNonFiniteNumber)
+			return
+		}
+	}
+
+	// Pair each minimum with its maximum, skipping the longitudes.
+	half := len(b) / 2
+	for i := idxCoordsLat; i < half; i++ {
+		if b[i] > b[i+half] {
+			v.report(path, ErrBBoxOrder)
+			return
+		}
+	}
+
+	// The order of the minimums and maximums was checked above, so the region is valid.
+	r, _ := newRegion(b)
+	minAlt, maxAlt := altitudeRange(b)
+
+	walk(func(c Coordinates) bool {
+		if !bboxEncloses(r, minAlt, maxAlt, c) {
+			v.report(path, ErrBBoxNotEnclosing)
+			return false
+		}
+		return true
+	})
+}
+
+// bboxEncloses reports whether the position lies within the region and the altitude range of a bounding box,
+// boundary included. Altitude is only compared when the position has one.
+func bboxEncloses(r *region, minAlt, maxAlt float64, c Coordinates) bool {
+	if len(c) < coordsMinLen || !r.contains(c) {
+		return false
+	}
+
+	return !c.HasAltitude() || (minAlt <= c.Altitude() && c.Altitude() <= maxAlt)
+}
+
+// memberPath appends a member name to a path.
+func memberPath(path, member string) string {
+	if path == "" {
+		return member
+	}
+
+	return path + "." + member
+}
+
+// indexPath appends an array index to a path.
+func indexPath(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
@@ -0,0 +1,189 @@
+package geojson
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeature_ValidateRFC7946(t *testing.T) {
+	tests := []struct {
+		name          string
+		feature       *Feature
+		expectedPaths []string
+		expectedErrs  []error
+	}{
+		{
+			name: "valid feature",
+			feature: &Feature{
+				Geometry:   MustPoint([]float64{12.4924, 41.8902}),
+				Properties: Properties{"name": "Colosseum"},
+				ID:         NewStringID("colosseum"),
+			},
+		},
+		{
+			name:    "null geometry and properties",
+			feature: &Feature{},
+		},
+		{
+			name:          "missing geometry member",
+			feature:       &Feature{geometryAbsent: true},
+			expectedPaths: []string{"geometry"},
+			expectedErrs:  []error{ErrMissingGeometryMember},
+		},
+		{
+			name:          "position out of range",
+			feature:       &Feature{Geometry: &LineString{vertices: Vertices{{0, 0}, {200, 0}}}},
+			expectedPaths: []string{"geometry.coordinates[1]"},
+			expectedErrs:  []error{ErrLongitudeRange},
+		},
+		{
+			name:          "line string too short",
+			feature:       &Feature{Geometry: &LineString{vertices: Vertices{{0, 0}}}},
+			expectedPaths: []string{"geometry.coordinates"},
+			expectedErrs:  []error{ErrLineStringTooShort},
+		},
+		{
+			name: "open ring in multi polygon",
+			feature: &Feature{Geometry: &MultiPolygon{rings: []LinearRings{
+				{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+				{{{0, 0}, {1, 0}, {1, 1}, {0, 1}}},
+			}}},
+			expectedPaths: []string{"geometry.coordinates[1][0]"},
+			expectedErrs:  []error{ErrLinearRingClosed},
+		},
+		{
+			name: "nested geometry collection",
+			feature: &Feature{Geometry: NewGeometryCollectionFromSlice([]Geometry{
+				MustPoint([]float64{0, 0}),
+				NewGeometryCollectionFromSlice([]Geometry{&Point{coords: Coordinates{0, math.NaN()}}, nil}),
+			})},
+			expectedPaths: []string{"geometry.geometries[1]", "geometry.geometries[1].geometries[0].coordinates", "geometry.geometries[1].geometries[1]"},
+			expectedErrs:  []error{ErrNestedGeometryCollection, ErrNonFiniteNumber, ErrGeometryNotDefined},
+		},
+		{
+			name:          "declared bbox out of order",
+			feature:       &Feature{Geometry: &Point{coords: Coordinates{0, 0}, declaredBBox: declaredBBox{BoundingBox{0, 10, 0, -10}}}},
+			expectedPaths: []string{"geometry.bbox"},
+			expectedErrs:  []error{ErrBBoxOrder},
+		},
+		{
+			name:          "declared bbox crossing the antimeridian",
+			feature:       &Feature{Geometry: &Point{coords: Coordinates{179, 0}, declaredBBox: declaredBBox{BoundingBox{170, -10, -170, 10}}}},
+			expectedPaths: nil,
+		},
+		{
+			name:          "declared bbox not enclosing the geometry",
+			feature:       &Feature{Geometry: &LineString{vertices: Vertices{{0, 0}, {5, 5}}, declaredBBox: declaredBBox{BoundingBox{0, 0, 4, 5}}}},
+			expectedPaths: []string{"geometry.bbox"},
+			expectedErrs:  []error{ErrBBoxNotEnclosing},
+		},
+		{
+			name:          "declared bbox crossing the antimeridian not enclosing the geometry",
+			feature:       &Feature{Geometry: &Point{coords: Coordinates{0, 0}, declaredBBox: declaredBBox{BoundingBox{170, -10, -170, 10}}}},
+			expectedPaths: []string{"geometry.bbox"},
+			expectedErrs:  []error{ErrBBoxNotEnclosing},
+		},
+		{
+			name:          "declared 3D bbox not enclosing the altitude",
+			feature:       &Feature{Geometry: &Point{coords: Coordinates{0, 0, 120}, declaredBBox: declaredBBox{BoundingBox{0, 0, 0, 0, 0, 100}}}},
+			expectedPaths: []string{"geometry.bbox"},
+			expectedErrs:  []error{ErrBBoxNotEnclosing},
+		},
+		{
+			name: "invalid properties and id",
+			feature: &Feature{
+				Properties: Properties{"callback": func() {}},
+				ID:         &ID{},
+			},
+			expectedPaths: []string{"properties.callback", "id"},
+			expectedErrs:  []error{ErrInvalidProperties, ErrInvalidID},
+		},
+		{
+			name:          "non-finite numeric id",
+			feature:       &Feature{ID: NewNumericID(math.Inf(1))},
+			expectedPaths: []string{"id"},
+			expectedErrs:  []error{ErrNonFiniteNumber},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.feature.ValidateRFC7946()
+			require.Len(t, errs, len(tt.expectedPaths))
+			for i, err := range errs {
+				var validationErr *ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tt.expectedPaths[i], validationErr.Path)
+				assert.ErrorIs(t, err, tt.expectedErrs[i])
+			}
+		})
+	}
+}
+
+func TestFeatureCollection_ValidateRFC7946(t *testing.T) {
+	t.Run("aggregates violations of all features", func(t *testing.T) {
+		fc := &FeatureCollection{Features: []Feature{
+			{Geometry: MustPoint([]float64{0, 0})},
+			{Geometry: &Polygon{rings: LinearRings{{{0, 0}, {1, 1}, {0, 0}}}}},
+			{ID: &ID{}},
+		}}
+
+		errs := fc.ValidateRFC7946()
+		require.Len(t, errs, 2)
+		assert.EqualError(t, errs[0], "features[1].geometry.coordinates[0]: "+ErrLinearRingSize.Error())
+		assert.EqualError(t, errs[1], "features[2].id: "+ErrInvalidID.Error())
+	})
+
+	t.Run("null features member", func(t *testing.T) {
+		var fc FeatureCollection
+		require.NoError(t, json.Unmarshal([]byte(`{"type":"FeatureCollection","features":null}`), &fc))
+
+		errs := fc.ValidateRFC7946()
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidFeaturesMember)
+	})
+
+	t.Run("declared bbox not enclosing the features", func(t *testing.T) {
+		var fc FeatureCollection
+		data := `{"type":"FeatureCollection","bbox":[0,0,1,1],"features":[` +
+			`{"type":"Feature","geometry":{"type":"Point","coordinates":[2,2]},"properties":null}]}`
+		require.NoError(t, json.Unmarshal([]byte(data), &fc))
+
+		errs := fc.ValidateRFC7946()
+		require.Len(t, errs, 1)
+		assert.EqualError(t, errs[0], "bbox: "+ErrBBoxNotEnclosing.Error())
+	})
+
+	t.Run("valid collection", func(t *testing.T) {
+		assert.Nil(t, NewFeatureCollection().ValidateRFC7946())
+	})
+}
+
+func TestFeatureCollection_Validate(t *testing.T) {
+	fc := &FeatureCollection{Features: []Feature{
+		{Geometry: &LineString{vertices: Vertices{{0, 0}}}},
+		{Geometry: MustPoint([]float64{0, 0})},
+		{Geometry: &Polygon{rings: LinearRings{{{0, 0}, {1, 0}, {1, 1}, {0, 95}}}}},
+	}}
+
+	err := fc.Validate()
+	assert.ErrorIs(t, err, ErrLineStringTooShort)
+	assert.ErrorIs(t, err, ErrLinearRingClosed)
+	assert.ErrorIs(t, err, ErrLatitudeRange)
+	assert.EqualError(t, err, strings.Join([]string{
+		"features[0].geometry.coordinates: " + ErrLineStringTooShort.Error(),
+		"features[2].geometry.coordinates[0]: " + ErrLinearRingClosed.Error(),
+		"features[2].geometry.coordinates[0][3]: " + ErrLatitudeRange.Error(),
+	}, "\n"))
+
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "features[0].geometry.coordinates", validationErr.Path)
+
+	assert.NoError(t, NewFeatureCollection().Validate())
+}
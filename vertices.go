@@ -1,6 +1,10 @@
 package geojson
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+	"slices"
+)
 
 // Vertices represents a slice of Coordinates, used to define geometric shapes.
 type Vertices []Coordinates
@@ -44,3 +48,127 @@ func (vb *VerticesBuilder) Build() (Vertices, error) {
 
 	return vb.vertices, nil
 }
+
+// snapVertices returns a copy of the vertices with longitude and latitude rounded to the nearest
+// multiple of gridSize. Consecutive vertices that become equal after snapping are collapsed into one.
+// Altitude values are left untouched.
+func snapVertices(v Vertices, gridSize float64) Vertices {
+	out := make(Vertices, 0, len(v))
+	for _, c := range v {
+		snapped := make(Coordinates, len(c))
+		copy(snapped, c)
+		snapped[idxCoordsLng] = math.Round(c[idxCoordsLng]/gridSize) * gridSize
+		snapped[idxCoordsLat] = math.Round(c[idxCoordsLat]/gridSize) * gridSize
+
+		if len(out) > 0 && out[len(out)-1].IsEqual(snapped) {
+			continue
+		}
+		out = append(out, snapped)
+	}
+
+	return out
+}
+
+// simplifyVertices returns a copy of the vertices simplified with the Ramer-Douglas-Peucker algorithm:
+// positions closer than tolerance, in degrees, to the segment joining the kept positions around them
+// are dropped. The first and last positions are always kept.
+func simplifyVertices(v Vertices, tolerance float64) Vertices {
+	if len(v) <= LineStringMinimumSize {
+		return cloneVertices(v)
+	}
+
+	keep := make([]bool, len(v))
+	keep[0], keep[len(v)-1] = true, true
+
+	// Process ranges iteratively with an explicit stack to avoid deep recursion on long inputs.
+	stack := [][2]int{{0, len(v) - 1}}
+	for len(stack) > 0 {
+		first, last := stack[len(stack)-1][0], stack[len(stack)-1][1]
+		stack = stack[:len(stack)-1]
+
+		index, maxDistance := -1, 0.0
+		for i := first + 1; i < last; i++ {
+			if d := planarDistanceToSegment(v[i], v[first], v[last]); d > maxDistance {
+				index, maxDistance = i, d
+			}
+		}
+
+		if index >= 0 && maxDistance > tolerance {
+			keep[index] = true
+			stack = append(stack, [2]int{first, index}, [2]int{index, last})
+		}
+	}
+
+	var out Vertices
+	for i, c := range v {
+		if keep[i] {
+			out = append(out, slices.Clone(c))
+		}
+	}
+
+	return out
+}
+
+// densifyVertices returns a copy of the vertices with positions linearly interpolated along every segment
+// longer than maxSegmentLength, in degrees, so that no segment exceeds it. Each long segment is divided into
+// equal parts. A non-positive maxSegmentLength leaves the segments unchanged.
+func densifyVertices(v Vertices, maxSegmentLength float64) Vertices {
+	if len(v) == 0 || maxSegmentLength <= 0 || math.IsNaN(maxSegmentLength) {
+		return cloneVertices(v)
+	}
+
+	out := Vertices{slices.Clone(v[0])}
+	for i := 1; i < len(v); i++ {
+		a, b := v[i-1], v[i]
+
+		length := math.Hypot(b[idxCoordsLng]-a[idxCoordsLng], b[idxCoordsLat]-a[idxCoordsLat])
+		parts := math.Ceil(length / maxSegmentLength)
+		for k := 1.0; k < parts; k++ {
+			out = append(out, interpolateLinear(a, b, k/parts))
+		}
+
+		out = append(out, slices.Clone(b))
+	}
+
+	return out
+}
+
+// subsampleVertices returns a copy of every nth vertex, starting from the first, followed by the last vertex
+// if it was not kept already. A value of n lower than 2 keeps every vertex.
+func subsampleVertices(v Vertices, n int) Vertices {
+	if n <= 1 || len(v) <= LineStringMinimumSize {
+		return cloneVertices(v)
+	}
+
+	out := make(Vertices, 0, (len(v)+n-1)/n+1)
+	for i := 0; i < len(v); i += n {
+		out = append(out, slices.Clone(v[i]))
+	}
+	if (len(v)-1)%n != 0 {
+		out = append(out, slices.Clone(v[len(v)-1]))
+	}
+
+	return out
+}
+
+// verticesLength returns the sum of the great-circle distances in meters between consecutive positions.
+func verticesLength(v Vertices) float64 {
+	length := 0.0
+	for i := 1; i < len(v); i++ {
+		length += v[i-1].Distance(v[i])
+	}
+	return length
+}
+
+// cloneVertices returns a deep copy of the vertices.
+func cloneVertices(v Vertices) Vertices {
+	if v == nil {
+		return nil
+	}
+
+	out := make(Vertices, len(v))
+	for i, c := range v {
+		out[i] = slices.Clone(c)
+	}
+	return out
+}
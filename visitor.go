@@ -0,0 +1,93 @@
+package geojson
+
+// GeometryVisitor handles each kind of geometry. It is passed to GeometryObject.Accept, which calls
+// the method matching the type of the geometry. Embed BaseGeometryVisitor to handle only some kinds.
+type GeometryVisitor interface {
+	// VisitPoint handles a Point.
+	VisitPoint(p *Point) error
+	// VisitMultiPoint handles a MultiPoint.
+	VisitMultiPoint(m *MultiPoint) error
+	// VisitLineString handles a LineString.
+	VisitLineString(l *LineString) error
+	// VisitMultiLineString handles a MultiLineString.
+	VisitMultiLineString(m *MultiLineString) error
+	// VisitPolygon handles a Polygon.
+	VisitPolygon(p *Polygon) error
+	// VisitMultiPolygon handles a MultiPolygon.
+	VisitMultiPolygon(m *MultiPolygon) error
+	// VisitGeometryCollection handles a GeometryCollection. Its children are not visited automatically;
+	// call AcceptGeometry on each of them to recurse.
+	VisitGeometryCollection(gc *GeometryCollection) error
+}
+
+// BaseGeometryVisitor is a GeometryVisitor whose methods do nothing and return nil.
+// Embed it in a struct to implement only the methods for the geometry kinds of interest.
+type BaseGeometryVisitor struct{}
+
+// VisitPoint does nothing and returns nil.
+func (BaseGeometryVisitor) VisitPoint(*Point) error {
+	return nil
+}
+
+// VisitMultiPoint does nothing and returns nil.
+func (BaseGeometryVisitor) VisitMultiPoint(*MultiPoint) error {
+	return nil
+}
+
+// VisitLineString does nothing and returns nil.
+func (BaseGeometryVisitor) VisitLineString(*LineString) error {
+	return nil
+}
+
+// VisitMultiLineString does nothing and returns nil.
+func (BaseGeometryVisitor) VisitMultiLineString(*MultiLineString) error {
+	return nil
+}
+
+// VisitPolygon does nothing and returns nil.
+func (BaseGeometryVisitor) VisitPolygon(*Polygon) error {
+	return nil
+}
+
+// VisitMultiPolygon does nothing and returns nil.
+func (BaseGeometryVisitor) VisitMultiPolygon(*MultiPolygon) error {
+	return nil
+}
+
+// VisitGeometryCollection does nothing and returns nil.
+func (BaseGeometryVisitor) VisitGeometryCollection(*GeometryCollection) error {
+	return nil
+}
+
+// Accept calls the method of the visitor matching the type of the geometry and returns its error.
+// It returns ErrGeometryNotDefined if the GeometryObject is empty.
+func (g *GeometryObject) Accept(v GeometryVisitor) error {
+	if g.IsEmpty() {
+		return ErrGeometryNotDefined
+	}
+
+	return AcceptGeometry(g.geometry, v)
+}
+
+// AcceptGeometry calls the method of the visitor matching the type of the geometry and returns its error.
+// It returns ErrGeometryNotDefined if the geometry is nil.
+func AcceptGeometry(g Geometry, v GeometryVisitor) error {
+	switch g := g.(type) {
+	case *Point:
+		return v.VisitPoint(g)
+	case *MultiPoint:
+		return v.VisitMultiPoint(g)
+	case *LineString:
+		return v.VisitLineString(g)
+	case *MultiLineString:
+		return v.VisitMultiLineString(g)
+	case *Polygon:
+		return v.VisitPolygon(g)
+	case *MultiPolygon:
+		return v.VisitMultiPolygon(g)
+	case *GeometryCollection:
+		return v.VisitGeometryCollection(g)
+	default:
+		return ErrGeometryNotDefined
+	}
+}
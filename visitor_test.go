@@ -0,0 +1,111 @@
+package geojson
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingVisitor records the type of each visited geometry, recursing into collections.
+type recordingVisitor struct {
+	visited []GeometryType
+}
+
+func (r *recordingVisitor) VisitPoint(p *Point) error {
+	r.visited = append(r.visited, p.Type())
+	return nil
+}
+
+func (r *recordingVisitor) VisitMultiPoint(m *MultiPoint) error {
+	r.visited = append(r.visited, m.Type())
+	return nil
+}
+
+func (r *recordingVisitor) VisitLineString(l *LineString) error {
+	r.visited = append(r.visited, l.Type())
+	return nil
+}
+
+func (r *recordingVisitor) VisitMultiLineString(m *MultiLineString) error {
+	r.visited = append(r.visited, m.Type())
+	return nil
+}
+
+func (r *recordingVisitor) VisitPolygon(p *Polygon) error {
+	r.visited = append(r.visited, p.Type())
+	return nil
+}
+
+func (r *recordingVisitor) VisitMultiPolygon(m *MultiPolygon) error {
+	r.visited = append(r.visited, m.Type())
+	return nil
+}
+
+func (r *recordingVisitor) VisitGeometryCollection(gc *GeometryCollection) error {
+	r.visited = append(r.visited, gc.Type())
+	for _, g := range gc.Geometries() {
+		if err := AcceptGeometry(g, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// polygonCounter counts polygons, relying on BaseGeometryVisitor for the other kinds.
+type polygonCounter struct {
+	BaseGeometryVisitor
+	count int
+}
+
+func (c *polygonCounter) VisitPolygon(*Polygon) error {
+	c.count++
+	return nil
+}
+
+func TestGeometryObject_Accept(t *testing.T) {
+	square := MustPolygon(LinearRings{*MustLinearRing(Vertices{{0, 0}, {1, 0}, {1, 1}, {0, 0}})})
+	collection := NewGeometryCollectionFromSlice([]Geometry{
+		MustPoint([]float64{1, 1}),
+		NewMultiPointFromVertices(Vertices{{0, 0}}),
+		MustLineString(Vertices{{0, 0}, {1, 1}}),
+		MustMultiLineString(Segments{{{0, 0}, {1, 1}}}),
+		square,
+		MustMultiPolygonFromRingSlice([]LinearRings{square.LinearRings()}),
+	})
+
+	recorder := &recordingVisitor{}
+	object := collection.AsGeometryObject()
+	require.NoError(t, object.Accept(recorder))
+	assert.Equal(t, []GeometryType{
+		TypeGeometryCollection, TypePoint, TypeMultiPoint, TypeLineString,
+		TypeMultiLineString, TypePolygon, TypeMultiPolygon,
+	}, recorder.visited)
+
+	counter := &polygonCounter{}
+	object = square.AsGeometryObject()
+	require.NoError(t, object.Accept(counter))
+	object = MustPoint([]float64{0, 0}).AsGeometryObject()
+	require.NoError(t, object.Accept(counter))
+	assert.Equal(t, 1, counter.count)
+
+	empty := GeometryObject{}
+	assert.ErrorIs(t, empty.Accept(counter), ErrGeometryNotDefined)
+	assert.ErrorIs(t, AcceptGeometry(nil, counter), ErrGeometryNotDefined)
+}
+
+// failingVisitor returns an error for every LineString.
+type failingVisitor struct {
+	BaseGeometryVisitor
+}
+
+func (failingVisitor) VisitLineString(*LineString) error {
+	return errors.New("line strings are not supported")
+}
+
+func TestAcceptGeometry_Error(t *testing.T) {
+	err := AcceptGeometry(MustLineString(Vertices{{0, 0}, {1, 1}}), failingVisitor{})
+	assert.EqualError(t, err, "line strings are not supported")
+	assert.NoError(t, AcceptGeometry(MustPoint([]float64{0, 0}), failingVisitor{}))
+}
@@ -0,0 +1,65 @@
+package geojson
+
+import (
+	"math"
+)
+
+const (
+	// WebMercatorMaxLatitude is the latitude, north and south, at which the Web Mercator (EPSG:3857)
+	// projection is cut off so that the projected world is square.
+	WebMercatorMaxLatitude float64 = 85.05112878
+	// WebMercatorRadius is the radius in meters of the sphere used by the Web Mercator projection,
+	// the semi-major axis of the WGS84 ellipsoid.
+	WebMercatorRadius float64 = 6378137
+)
+
+// ToWebMercator projects the longitude and latitude of the coordinates, in EPSG:4326, to Web Mercator
+// (EPSG:3857) x and y values in meters, using the spherical Mercator formulas. The latitude is clamped
+// to ±WebMercatorMaxLatitude, and altitude is ignored.
+func (c *Coordinates) ToWebMercator() (x, y float64) {
+	lat := math.Max(-WebMercatorMaxLatitude, math.Min(WebMercatorMaxLatitude, c.Latitude()))
+
+	x = WebMercatorRadius * degreesToRadians(c.Longitude())
+	y = WebMercatorRadius * math.Log(math.Tan(math.Pi/4+degreesToRadians(lat)/2))
+
+	return x, y
+}
+
+// FromWebMercator converts Web Mercator (EPSG:3857) x and y values in meters to longitude and latitude
+// coordinates, in EPSG:4326, using the inverse spherical Mercator formulas. It returns an error if x lies
+// outside the projected world, which would result in an out-of-range longitude.
+func FromWebMercator(x, y float64) (Coordinates, error) {
+	lng := radiansToDegrees(x / WebMercatorRadius)
+	lat := radiansToDegrees(2*math.Atan(math.Exp(y/WebMercatorRadius)) - math.Pi/2)
+
+	c, err := NewCoordinates([]float64{lng, lat})
+	if err != nil {
+		return nil, err
+	}
+
+	return *c, nil
+}
+
+// ToWebMercator returns a copy of the geometry with the longitude and latitude of every position projected
+// to Web Mercator (EPSG:3857) x and y values in meters, as Coordinates.ToWebMercator does. The structure of
+// the geometry and the altitudes are preserved, and the declared bounding box is dropped.
+//
+// The projected values are meters rather than degrees, so the result can be encoded, for instance to
+// pre-bake tiles, but fails Validate and the range checks applied when decoding, and methods measuring
+// distances or areas on the sphere do not apply to it. It returns ErrGeometryNotDefined for empty objects.
+func (g *GeometryObject) ToWebMercator() (*GeometryObject, error) {
+	if g.IsEmpty() {
+		return nil, ErrGeometryNotDefined
+	}
+
+	project := func(v Vertices) Vertices {
+		out := make(Vertices, len(v))
+		for i, c := range v {
+			x, y := c.ToWebMercator()
+			out[i] = append(Coordinates{x, y}, c[idxCoordsAlt:]...)
+		}
+		return out
+	}
+
+	return &GeometryObject{geometry: mapGeometry(g.geometry, project)}, nil
+}
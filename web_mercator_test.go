@@ -0,0 +1,69 @@
+package geojson
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoordinates_ToWebMercator(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     Coordinates
+		expectedX float64
+		expectedY float64
+	}{
+		{"origin", Coordinates{0, 0}, 0, 0},
+		{"antimeridian", Coordinates{180, 0}, 20037508.342789244, 0},
+		{"rome", Coordinates{12.4924, 41.8902, 45}, 1390647.607, 5144546.100},
+		{"latitude limit", Coordinates{0, WebMercatorMaxLatitude}, 0, 20037508.342789244},
+		{"clamped north", Coordinates{0, 90}, 0, 20037508.342789244},
+		{"clamped south", Coordinates{-180, -89}, -20037508.342789244, -20037508.342789244},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, y := tt.input.ToWebMercator()
+			assert.InDelta(t, tt.expectedX, x, 0.1)
+			assert.InDelta(t, tt.expectedY, y, 0.1)
+		})
+	}
+}
+
+func TestFromWebMercator(t *testing.T) {
+	for _, c := range []Coordinates{{0, 0}, {12.4924, 41.8902}, {-122.4194, 37.7749}, {179.9, -85}} {
+		x, y := c.ToWebMercator()
+		got, err := FromWebMercator(x, y)
+		require.NoError(t, err)
+		assert.InDeltaSlice(t, c, got, 1e-9)
+	}
+
+	_, err := FromWebMercator(2.1e7, 0)
+	assert.ErrorIs(t, err, ErrLongitudeRange)
+}
+
+func TestGeometryObject_ToWebMercator(t *testing.T) {
+	polygon := MustPolygon(LinearRings{{{0, 0, 10}, {1, 0, 10}, {1, 1, 10}, {0, 1, 10}, {0, 0, 10}}})
+	g := NewGeometryCollectionFromSlice([]Geometry{MustPoint([]float64{180, 0}), polygon}).AsGeometryObject()
+
+	projected, err := g.ToWebMercator()
+	require.NoError(t, err)
+	require.Equal(t, TypeGeometryCollection, projected.Type())
+
+	vertices := projected.geometry.Vertices()
+	require.Len(t, vertices, 6)
+	assert.InDeltaSlice(t, []float64{20037508.342789244, 0}, vertices[0], 1e-6)
+	assert.InDeltaSlice(t, []float64{111319.49079327357, 111325.14286638486, 10}, vertices[3], 1e-6)
+	assert.Equal(t, Coordinates{0, 0, 10}, polygon.rings[0][0], "the original is unchanged")
+
+	bbox := projected.geometry.BoundingBox()
+	assert.InDeltaSlice(t, []float64{0, 0, 0, 20037508.342789244, 111325.14286638486, 10}, bbox, 1e-6)
+
+	_, err = json.Marshal(projected)
+	assert.NoError(t, err)
+
+	_, err = (&GeometryObject{}).ToWebMercator()
+	assert.ErrorIs(t, err, ErrGeometryNotDefined)
+}
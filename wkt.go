@@ -0,0 +1,310 @@
+package geojson
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidWKT is returned when a Well-Known Text string is malformed or uses an unsupported variant.
+	ErrInvalidWKT = errors.New("invalid WKT")
+
+	// ErrEmptyWKT is returned when a Well-Known Text string describes an EMPTY geometry,
+	// which has no GeoJSON counterpart with positions.
+	ErrEmptyWKT = errors.New("empty WKT geometries are not supported")
+)
+
+const (
+	// wktEmpty is the keyword marking an empty geometry.
+	wktEmpty = "EMPTY"
+	// wktZ is the dimension keyword marking positions with altitude.
+	wktZ = "Z"
+)
+
+// ParseWKT parses a Well-Known Text string into the corresponding Geometry: POINT, LINESTRING, POLYGON,
+// MULTIPOINT, MULTILINESTRING, MULTIPOLYGON, or GEOMETRYCOLLECTION, matched case-insensitively.
+// Positions have 2 values, or 3 when the Z dimension is declared; undeclared 3D positions are also accepted.
+// Positions are validated like those of any other geometry, and polygon rings are oriented following the
+// right-hand rule. It returns ErrEmptyWKT for EMPTY geometries and ErrInvalidWKT for malformed input
+// or unsupported dimensions such as M and ZM.
+func ParseWKT(s string) (Geometry, error) {
+	p := &wktParser{s: s}
+
+	g, err := p.geometry()
+	if err != nil {
+		return nil, err
+	}
+
+	if token := p.next(); token != "" {
+		return nil, p.errorf("unexpected %q after geometry", token)
+	}
+
+	return g, nil
+}
+
+// wktParser is a recursive descent parser for Well-Known Text.
+type wktParser struct {
+	s   string // s is the input being parsed.
+	pos int    // pos is the offset of the next unread byte.
+	z   bool   // z reports whether the geometry being parsed declares the Z dimension.
+}
+
+// errorf returns an error wrapping ErrInvalidWKT, annotated with the current offset.
+func (p *wktParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s at offset %d", ErrInvalidWKT, fmt.Sprintf(format, args...), p.pos)
+}
+
+// next consumes and returns the next token: a parenthesis, a comma, a word, or a number.
+// It returns an empty string at the end of the input.
+func (p *wktParser) next() string {
+	token := p.peek()
+	p.pos += len(token)
+	return token
+}
+
+// peek returns the next token without consuming it, skipping any leading whitespace.
+func (p *wktParser) peek() string {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+	if p.pos == len(p.s) {
+		return ""
+	}
+
+	end := p.pos
+	switch c := p.s[end]; {
+	case c == '(' || c == ')' || c == ',':
+		end++
+	case isWKTLetter(c):
+		for end < len(p.s) && isWKTLetter(p.s[end]) {
+			end++
+		}
+	default:
+		for end < len(p.s) && strings.IndexByte("0123456789+-.eE", p.s[end]) >= 0 {
+			end++
+		}
+		if end == p.pos {
+			end++
+		}
+	}
+
+	return p.s[p.pos:end]
+}
+
+// expect consumes the next token, returning an error if it differs from the expected one.
+func (p *wktParser) expect(expected string) error {
+	if token := p.next(); token != expected {
+		return p.errorf("expected %q, found %q", expected, token)
+	}
+	return nil
+}
+
+// list parses a parenthesized, comma-separated list, calling item for each element.
+func (p *wktParser) list(item func() error) error {
+	if err := p.open(); err != nil {
+		return err
+	}
+
+	for {
+		if err := item(); err != nil {
+			return err
+		}
+
+		switch token := p.next(); token {
+		case ",":
+		case ")":
+			return nil
+		default:
+			return p.errorf("expected \",\" or \")\", found %q", token)
+		}
+	}
+}
+
+// open consumes an opening parenthesis, returning ErrEmptyWKT if the EMPTY keyword is found instead.
+func (p *wktParser) open() error {
+	if strings.EqualFold(p.peek(), wktEmpty) {
+		return ErrEmptyWKT
+	}
+
+	return p.expect("(")
+}
+
+// geometry parses a tagged geometry, including its type keyword and optional dimension.
+func (p *wktParser) geometry() (Geometry, error) {
+	keyword := strings.ToUpper(p.next())
+
+	switch dimension := strings.ToUpper(p.peek()); dimension {
+	case wktZ:
+		p.next()
+		p.z = true
+	case "M", "ZM":
+		return nil, p.errorf("unsupported dimension %q", dimension)
+	default:
+		p.z = false
+	}
+
+	switch keyword {
+	case "POINT":
+		var coords Coordinates
+		err := p.list(func() (err error) {
+			if coords != nil {
+				return p.errorf("point with more than one position")
+			}
+			coords, err = p.position()
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &Point{coords: coords}, nil
+	case "LINESTRING":
+		vertices, err := p.positions()
+		if err != nil {
+			return nil, err
+		}
+		return NewLineString(vertices)
+	case "POLYGON":
+		rings, err := p.rings()
+		if err != nil {
+			return nil, err
+		}
+		return NewPolygon(rings)
+	case "MULTIPOINT":
+		vertices, err := p.multiPointPositions()
+		if err != nil {
+			return nil, err
+		}
+		return NewMultiPointFromVertices(vertices), nil
+	case "MULTILINESTRING":
+		var segments Segments
+		err := p.list(func() error {
+			vertices, err := p.positions()
+			segments = append(segments, vertices)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return NewMultiLineString(segments)
+	case "MULTIPOLYGON":
+		var slice []LinearRings
+		err := p.list(func() error {
+			rings, err := p.rings()
+			slice = append(slice, rings)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return NewMultiPolygonFromRingSlice(slice)
+	case "GEOMETRYCOLLECTION":
+		var geometries []Geometry
+		err := p.list(func() error {
+			g, err := p.geometry()
+			geometries = append(geometries, g)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return NewGeometryCollectionFromSlice(geometries), nil
+	default:
+		return nil, p.errorf("unknown geometry type %q", keyword)
+	}
+}
+
+// rings parses the parenthesized list of linear rings of a polygon.
+func (p *wktParser) rings() (LinearRings, error) {
+	var rings LinearRings
+	err := p.list(func() error {
+		vertices, err := p.positions()
+		if err != nil {
+			return err
+		}
+
+		ring, err := NewLinearRing(vertices)
+		if err != nil {
+			return err
+		}
+
+		rings = append(rings, *ring)
+		return nil
+	})
+
+	return rings, err
+}
+
+// multiPointPositions parses the positions of a MULTIPOINT, which may or may not be individually parenthesized.
+func (p *wktParser) multiPointPositions() (Vertices, error) {
+	var vertices Vertices
+	err := p.list(func() error {
+		parenthesized := p.peek() == "("
+		if parenthesized || strings.EqualFold(p.peek(), wktEmpty) {
+			if err := p.open(); err != nil {
+				return err
+			}
+		}
+
+		coords, err := p.position()
+		if err != nil {
+			return err
+		}
+		vertices = append(vertices, coords)
+
+		if parenthesized {
+			return p.expect(")")
+		}
+		return nil
+	})
+
+	return vertices, err
+}
+
+// positions parses a parenthesized list of positions.
+func (p *wktParser) positions() (Vertices, error) {
+	var vertices Vertices
+	err := p.list(func() error {
+		coords, err := p.position()
+		vertices = append(vertices, coords)
+		return err
+	})
+
+	return vertices, err
+}
+
+// position parses a single position made of space-separated numbers and validates it.
+func (p *wktParser) position() (Coordinates, error) {
+	var values []float64
+	for {
+		token := p.peek()
+		if token == "" || token == "," || token == ")" {
+			break
+		}
+
+		// Keywords such as NaN and Inf are accepted by strconv but are not WKT numbers.
+		v, err := strconv.ParseFloat(token, 64)
+		if err != nil || isWKTLetter(token[0]) {
+			return nil, p.errorf("invalid number %q", token)
+		}
+		p.next()
+		values = append(values, v)
+	}
+
+	if p.z && len(values) != coordsMaxLen {
+		return nil, p.errorf("expected %d values for a Z position, found %d", coordsMaxLen, len(values))
+	}
+
+	coords, err := NewCoordinates(values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse WKT position at offset %d: %w", p.pos, err)
+	}
+
+	return *coords, nil
+}
+
+// isWKTLetter reports whether c can be part of a WKT keyword.
+func isWKTLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
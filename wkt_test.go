@@ -0,0 +1,116 @@
+package geojson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWKT(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected Geometry
+	}{
+		{
+			name:     "point",
+			input:    "POINT (12.4924 41.8902)",
+			expected: MustPoint([]float64{12.4924, 41.8902}),
+		},
+		{
+			name:     "point z lowercase",
+			input:    "point z(1 2 3)",
+			expected: MustPoint([]float64{1, 2, 3}),
+		},
+		{
+			name:     "line string",
+			input:    "LINESTRING(30 10, 10 30, 40 40)",
+			expected: MustLineString(Vertices{{30, 10}, {10, 30}, {40, 40}}),
+		},
+		{
+			name:  "polygon with hole",
+			input: "POLYGON ((35 10, 45 45, 15 40, 10 20, 35 10), (20 30, 35 35, 30 20, 20 30))",
+			expected: MustPolygon(LinearRings{
+				*MustLinearRing(Vertices{{35, 10}, {45, 45}, {15, 40}, {10, 20}, {35, 10}}),
+				*MustLinearRing(Vertices{{20, 30}, {35, 35}, {30, 20}, {20, 30}}),
+			}),
+		},
+		{
+			name:     "multi point with parentheses",
+			input:    "MULTIPOINT ((10 40), (40 30))",
+			expected: NewMultiPointFromVertices(Vertices{{10, 40}, {40, 30}}),
+		},
+		{
+			name:     "multi point without parentheses",
+			input:    "MULTIPOINT (10 40, 40 30)",
+			expected: NewMultiPointFromVertices(Vertices{{10, 40}, {40, 30}}),
+		},
+		{
+			name:     "multi line string z",
+			input:    "MULTILINESTRING Z ((10 10 1, 20 20 2), (40 40 3, 30 30 4))",
+			expected: MustMultiLineString(Segments{{{10, 10, 1}, {20, 20, 2}}, {{40, 40, 3}, {30, 30, 4}}}),
+		},
+		{
+			name:  "multi polygon",
+			input: "MULTIPOLYGON (((30 20, 45 40, 10 40, 30 20)), ((15 5, 40 10, 10 20, 5 10, 15 5)))",
+			expected: MustMultiPolygonFromRingSlice([]LinearRings{
+				{*MustLinearRing(Vertices{{30, 20}, {45, 40}, {10, 40}, {30, 20}})},
+				{*MustLinearRing(Vertices{{15, 5}, {40, 10}, {10, 20}, {5, 10}, {15, 5}})},
+			}),
+		},
+		{
+			name:  "geometry collection",
+			input: "GEOMETRYCOLLECTION (POINT (40 10), LINESTRING (10 10, 20 20, 10 40))",
+			expected: NewGeometryCollectionFromSlice([]Geometry{
+				MustPoint([]float64{40, 10}),
+				MustLineString(Vertices{{10, 10}, {20, 20}, {10, 40}}),
+			}),
+		},
+		{
+			name:     "scientific notation",
+			input:    "POINT (1.5e1 -2E-1)",
+			expected: MustPoint([]float64{15, -0.2}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := ParseWKT(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, g)
+		})
+	}
+}
+
+func TestParseWKT_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expectedErr error
+	}{
+		{"empty point", "POINT EMPTY", ErrEmptyWKT},
+		{"empty member", "MULTIPOINT ((1 2), EMPTY)", ErrEmptyWKT},
+		{"empty collection", "GEOMETRYCOLLECTION EMPTY", ErrEmptyWKT},
+		{"longitude out of range", "POINT (200 10)", ErrLongitudeRange},
+		{"latitude out of range", "LINESTRING (0 0, 10 95)", ErrLatitudeRange},
+		{"missing z value", "POINT Z (1 2)", ErrInvalidWKT},
+		{"measured dimension", "POINT M (1 2 3)", ErrInvalidWKT},
+		{"too many values", "POINT (1 2 3 4)", ErrCoordinatesSize},
+		{"unknown type", "CIRCLE (1 2)", ErrInvalidWKT},
+		{"unclosed list", "LINESTRING (1 2, 3 4", ErrInvalidWKT},
+		{"trailing text", "POINT (1 2) foo", ErrInvalidWKT},
+		{"invalid number", "POINT (1 NaN)", ErrInvalidWKT},
+		{"open ring", "POLYGON ((0 0, 1 0, 1 1, 0 1))", ErrLinearRingClosed},
+		{"short line string", "LINESTRING (0 0)", ErrLineStringTooShort},
+		{"empty string", "", ErrInvalidWKT},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := ParseWKT(tt.input)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Nil(t, g)
+		})
+	}
+}